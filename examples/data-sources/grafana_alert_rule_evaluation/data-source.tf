@@ -0,0 +1,28 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Alert Rule Folder"
+}
+
+data "grafana_alert_rule_evaluation" "high_value" {
+  folder_uid = grafana_folder.rule_folder.uid
+  name       = "High value"
+  condition  = "A"
+  labels = {
+    severity = "critical"
+  }
+  annotations = {
+    summary = "The value is {{ $values.A.Value }}"
+  }
+
+  data {
+    ref_id         = "A"
+    datasource_uid = "-100"
+    relative_time_range {
+      from = 0
+      to   = 0
+    }
+    model = jsonencode({
+      type       = "math"
+      expression = "2 > 1"
+    })
+  }
+}
@@ -0,0 +1,17 @@
+resource "grafana_alerting_silence" "maintenance" {
+  ends_at = "2030-01-01T04:00:00Z"
+  comment = "Database maintenance window"
+
+  matcher {
+    label = "service"
+    match = "="
+    value = "database"
+  }
+}
+
+data "grafana_alerting_silences" "database" {
+  filter          = ["service=\"database\""]
+  include_pending = true
+
+  depends_on = [grafana_alerting_silence.maintenance]
+}
@@ -0,0 +1,11 @@
+resource "grafana_contact_point" "my_contact_point" {
+  name = "My Contact Point"
+
+  email {
+    addresses = ["one@company.org", "two@company.org"]
+  }
+}
+
+data "grafana_contact_point" "from_name" {
+  name = grafana_contact_point.my_contact_point.name
+}
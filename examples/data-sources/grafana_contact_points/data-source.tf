@@ -0,0 +1,14 @@
+resource "grafana_contact_point" "my_contact_point" {
+  name = "My Contact Point"
+
+  email {
+    addresses = ["one@company.org"]
+  }
+  webhook {
+    url = "http://my-url"
+  }
+}
+
+data "grafana_contact_points" "all" {
+  depends_on = [grafana_contact_point.my_contact_point]
+}
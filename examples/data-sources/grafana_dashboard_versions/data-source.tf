@@ -0,0 +1,10 @@
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    title = "Production Overview"
+  })
+}
+
+data "grafana_dashboard_versions" "test" {
+  dashboard_uid = grafana_dashboard.test.uid
+  limit         = 10
+}
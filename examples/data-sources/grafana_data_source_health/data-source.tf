@@ -0,0 +1,23 @@
+resource "grafana_data_source" "testdata" {
+  type         = "grafana-testdata-datasource"
+  name         = "testdata"
+  health_check = "error" // Fail the apply if the data source isn't healthy
+}
+
+resource "grafana_data_source" "prometheus" {
+  type         = "prometheus"
+  name         = "prometheus"
+  url          = "http://prometheus.invalid:9090"
+  health_check = "warn" // Only warn if the data source isn't healthy
+}
+
+data "grafana_data_source_health" "prometheus" {
+  uid = grafana_data_source.prometheus.uid
+}
+
+check "prometheus_health" {
+  assert {
+    condition     = data.grafana_data_source_health.prometheus.healthy
+    error_message = "Prometheus is not healthy: ${data.grafana_data_source_health.prometheus.message}"
+  }
+}
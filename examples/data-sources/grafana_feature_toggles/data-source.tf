@@ -0,0 +1,46 @@
+data "grafana_feature_toggles" "enabled" {}
+
+resource "grafana_folder" "rule_folder" {
+  title = "My Alert Rule Folder"
+}
+
+resource "grafana_contact_point" "email" {
+  name = "My Contact Point"
+  email {
+    addresses = ["one@company.org"]
+  }
+}
+
+resource "grafana_rule_group" "routed" {
+  name             = "My Routed Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+
+  rule {
+    name      = "My Routed Alert Rule"
+    condition = "A"
+    data {
+      ref_id         = "A"
+      datasource_uid = "__expr__"
+      relative_time_range {
+        from = 0
+        to   = 0
+      }
+      model = jsonencode({
+        expression = "0 > 1"
+        type       = "math"
+        refId      = "A"
+      })
+    }
+    notification_settings {
+      contact_point = grafana_contact_point.email.name
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.grafana_feature_toggles.enabled.enabled, "alertingSimplifiedRouting")
+      error_message = "The alertingSimplifiedRouting feature toggle must be enabled to set the notification settings of the rules."
+    }
+  }
+}
@@ -0,0 +1,24 @@
+resource "grafana_folder" "parent" {
+  title = "Tree Parent"
+  uid   = "tree-parent"
+}
+
+resource "grafana_folder" "child" {
+  title             = "Tree Child"
+  uid               = "tree-child"
+  parent_folder_uid = grafana_folder.parent.uid
+}
+
+resource "grafana_folder" "grandchild" {
+  title             = "Tree Grandchild"
+  uid               = "tree-grandchild"
+  parent_folder_uid = grafana_folder.child.uid
+}
+
+data "grafana_folder_tree" "from_parent" {
+  root_folder_uid = grafana_folder.parent.uid
+
+  depends_on = [
+    grafana_folder.grandchild,
+  ]
+}
@@ -0,0 +1,10 @@
+data "grafana_ldap_user_lookup" "editor" {
+  username = "ldap-editor"
+}
+
+check "ldap_editor_mapping" {
+  assert {
+    condition     = contains(data.grafana_ldap_user_lookup.editor.roles[*].org_role, "Editor")
+    error_message = "ldap-editor should be an editor of the default organization"
+  }
+}
@@ -32,3 +32,8 @@ resource "grafana_library_panel" "folder" {
 data "grafana_library_panels" "all" {
   depends_on = [grafana_library_panel.folder, grafana_library_panel.test]
 }
+
+data "grafana_library_panels" "in_folder" {
+  folder_uid = grafana_folder.test.uid
+  depends_on = [grafana_library_panel.folder, grafana_library_panel.test]
+}
@@ -0,0 +1,11 @@
+resource "grafana_mute_timing" "my_mute_timing" {
+  name = "My Mute Timing"
+
+  intervals {
+    weekdays = ["saturday", "sunday"]
+  }
+}
+
+data "grafana_mute_timing" "from_name" {
+  name = grafana_mute_timing.my_mute_timing.name
+}
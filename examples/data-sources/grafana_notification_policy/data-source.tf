@@ -0,0 +1,2 @@
+data "grafana_notification_policy" "current" {
+}
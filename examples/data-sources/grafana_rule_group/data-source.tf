@@ -0,0 +1,32 @@
+resource "grafana_folder" "rule_folder" {
+  title = "My Rule Folder"
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+  name             = "My Rule Group"
+  folder_uid       = grafana_folder.rule_folder.uid
+  interval_seconds = 60
+
+  rule {
+    name      = "My Alert Rule"
+    condition = "A"
+
+    data {
+      ref_id         = "A"
+      datasource_uid = "-100"
+      relative_time_range {
+        from = 0
+        to   = 0
+      }
+      model = jsonencode({
+        type       = "math"
+        expression = "2 > 1"
+      })
+    }
+  }
+}
+
+data "grafana_rule_group" "from_name" {
+  name       = grafana_rule_group.my_rule_group.name
+  folder_uid = grafana_rule_group.my_rule_group.folder_uid
+}
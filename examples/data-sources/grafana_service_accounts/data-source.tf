@@ -0,0 +1,27 @@
+resource "grafana_service_account" "ci" {
+  name = "ci"
+  role = "Editor"
+}
+
+resource "grafana_service_account_token" "ci" {
+  name               = "ci-token"
+  service_account_id = grafana_service_account.ci.id
+  seconds_to_live    = 86400
+}
+
+data "grafana_service_accounts" "editors" {
+  role     = "Editor"
+  disabled = false
+
+  depends_on = [grafana_service_account_token.ci]
+}
+
+// Tokens expiring in the next 7 days
+output "expiring_tokens" {
+  value = flatten([
+    for sa in data.grafana_service_accounts.editors.service_accounts : [
+      for token in sa.tokens : "${sa.name}/${token.name}"
+      if token.expiration != "" && timecmp(token.expiration, timeadd(plantimestamp(), "168h")) < 0
+    ]
+  ])
+}
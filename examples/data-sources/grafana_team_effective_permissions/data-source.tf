@@ -0,0 +1,30 @@
+resource "grafana_team" "sre" {
+  name = "SRE"
+}
+
+resource "grafana_role" "folders_reader" {
+  name                   = "Folders Reader"
+  uid                    = "folders-reader"
+  auto_increment_version = true
+
+  permissions {
+    action = "folders:read"
+    scope  = "folders:*"
+  }
+}
+
+resource "grafana_role_assignment" "sre_folders_reader" {
+  role_uid = grafana_role.folders_reader.uid
+  teams    = [grafana_team.sre.id]
+}
+
+data "grafana_team_effective_permissions" "sre" {
+  team_id       = grafana_team.sre.id
+  action_prefix = "folders:"
+
+  depends_on = [grafana_role_assignment.sre_folders_reader]
+}
+
+output "sre_folder_permissions" {
+  value = data.grafana_team_effective_permissions.sre.permissions
+}
@@ -0,0 +1,14 @@
+resource "grafana_user" "alice" {
+  email    = "alice@example.com"
+  login    = "alice"
+  password = "my-password"
+}
+
+data "grafana_user_effective_permissions" "alice" {
+  user_id       = grafana_user.alice.user_id
+  action_prefix = "dashboards:"
+}
+
+output "alice_can_create_dashboards" {
+  value = contains(data.grafana_user_effective_permissions.alice.actions, "dashboards:create")
+}
@@ -0,0 +1,18 @@
+resource "grafana_alerting_silence" "maintenance" {
+  starts_at  = "2030-01-01T02:00:00Z"
+  ends_at    = "2030-01-01T04:00:00Z"
+  comment    = "Database maintenance window"
+  created_by = "platform-team"
+
+  matcher {
+    label = "service"
+    match = "="
+    value = "database"
+  }
+
+  matcher {
+    label = "severity"
+    match = "=~"
+    value = "warning|critical"
+  }
+}
@@ -0,0 +1,27 @@
+resource "grafana_dashboard" "incident" {
+  config_json = jsonencode({
+    title = "Incident Overview"
+    uid   = "incident-overview"
+  })
+}
+
+// Snapshot of the current version of a dashboard, expiring after a week
+resource "grafana_dashboard_snapshot" "from_dashboard" {
+  dashboard_uid = grafana_dashboard.incident.uid
+  name          = "Incident 1234"
+  expires       = 604800
+}
+
+// Snapshot of a raw dashboard model
+resource "grafana_dashboard_snapshot" "from_json" {
+  config_json = jsonencode({
+    title = "Frozen view"
+    panels = [{
+      id      = 1
+      type    = "text"
+      title   = "Summary"
+      gridPos = { x = 0, y = 0, w = 24, h = 4 }
+      options = { content = "The incident started at 10:00 UTC." }
+    }]
+  })
+}
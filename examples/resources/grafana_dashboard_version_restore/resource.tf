@@ -0,0 +1,25 @@
+resource "grafana_folder" "runbooks" {
+  title = "Runbooks"
+}
+
+// A dashboard edited from the UI
+resource "grafana_dashboard" "overview" {
+  folder = grafana_folder.runbooks.uid
+  config_json = jsonencode({
+    title = "Production Overview"
+  })
+
+  lifecycle {
+    ignore_changes = [config_json]
+  }
+}
+
+data "grafana_dashboard_versions" "overview" {
+  dashboard_uid = grafana_dashboard.overview.uid
+}
+
+// Roll back to the first version
+resource "grafana_dashboard_version_restore" "overview" {
+  dashboard_uid = grafana_dashboard.overview.uid
+  version       = data.grafana_dashboard_versions.overview.versions[length(data.grafana_dashboard_versions.overview.versions) - 1].version
+}
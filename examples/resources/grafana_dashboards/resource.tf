@@ -0,0 +1,21 @@
+resource "grafana_folder" "services" {
+  title = "Services"
+}
+
+resource "grafana_dashboards" "services" {
+  name        = "services"
+  folder      = grafana_folder.services.uid
+  concurrency = 8
+
+  config_json = {
+    api = jsonencode({
+      uid   = "services-api"
+      title = "API"
+    })
+    workers = jsonencode({
+      uid   = "services-workers"
+      title = "Workers"
+    })
+  }
+}
+
@@ -76,3 +76,38 @@ resource "grafana_data_source" "prometheus" {
   })
 }
 
+
+// The common settings of Prometheus, Loki, Tempo, Elasticsearch and CloudWatch can be set with typed blocks
+resource "grafana_data_source" "prometheus_typed" {
+  type = "prometheus"
+  name = "prometheus"
+  url  = "https://my-instances.com"
+
+  prometheus {
+    http_method     = "POST"
+    prometheus_type = "Mimir"
+    scrape_interval = "30s"
+
+    exemplar_trace_id_destinations {
+      name           = "traceID"
+      datasource_uid = grafana_data_source.tempo.uid
+    }
+  }
+}
+
+resource "grafana_data_source" "tempo" {
+  type = "tempo"
+  name = "tempo"
+  url  = "https://my-tempo.com"
+
+  tempo {
+    traces_to_logs {
+      datasource_uid     = "loki-uid"
+      filter_by_trace_id = true
+      tags {
+        key   = "service.name"
+        value = "service"
+      }
+    }
+  }
+}
@@ -0,0 +1,33 @@
+resource "grafana_data_source" "logs" {
+  type = "loki"
+  name = "logs"
+  url  = "http://loki:3100"
+}
+
+resource "grafana_data_source" "traces" {
+  type = "tempo"
+  name = "traces"
+  url  = "http://tempo:3200"
+}
+
+// Opens the trace of a log line, extracting the trace ID from the log message
+resource "grafana_data_source_correlation" "logs_to_traces" {
+  source_uid  = grafana_data_source.logs.uid
+  target_uid  = grafana_data_source.traces.uid
+  label       = "Open trace"
+  description = "Opens the trace of the log line"
+
+  config {
+    field = "Line"
+    target_json = jsonencode({
+      query     = "$${traceId}"
+      queryType = "traceql"
+    })
+
+    transformation {
+      type       = "regex"
+      expression = "traceId=(\\w+)"
+      map_value  = "traceId"
+    }
+  }
+}
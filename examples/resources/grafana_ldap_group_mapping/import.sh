@@ -0,0 +1 @@
+terraform import grafana_ldap_group_mapping.name "{{ serverHost }}:{{ orgID }}:{{ groupDN }}"
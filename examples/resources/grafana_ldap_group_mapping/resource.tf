@@ -0,0 +1,44 @@
+resource "grafana_sso_settings" "ldap" {
+  provider_name = "ldap"
+
+  ldap_settings {
+    enabled = "true"
+    config {
+      servers {
+        host                  = "openldap"
+        port                  = 389
+        search_filter         = "(cn=%s)"
+        ignore_group_mappings = true
+        bind_dn               = "cn=admin,dc=grafana,dc=org"
+        bind_password         = "grafana"
+        search_base_dns = [
+          "dc=grafana,dc=org",
+        ]
+        group_search_filter                = "(&(objectClass=groupOfNames)(member=%s))"
+        group_search_filter_user_attribute = "dn"
+        group_search_base_dns = [
+          "ou=groups,dc=grafana,dc=org",
+        ]
+        attributes = {
+          name     = "givenName"
+          surname  = "sn"
+          username = "cn"
+          email    = "mail"
+        }
+      }
+    }
+  }
+}
+
+resource "grafana_ldap_group_mapping" "admins" {
+  server_host   = grafana_sso_settings.ldap.ldap_settings[0].config[0].servers[0].host
+  group_dn      = "cn=admins,ou=groups,dc=grafana,dc=org"
+  org_role      = "Admin"
+  grafana_admin = true
+}
+
+resource "grafana_ldap_group_mapping" "editors" {
+  server_host = grafana_sso_settings.ldap.ldap_settings[0].config[0].servers[0].host
+  group_dn    = "cn=editors,ou=groups,dc=grafana,dc=org"
+  org_role    = "Editor"
+}
@@ -0,0 +1,2 @@
+terraform import grafana_org_invite.name "{{ email }}"
+terraform import grafana_org_invite.name "{{ orgID }}:{{ email }}"
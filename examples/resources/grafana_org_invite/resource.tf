@@ -0,0 +1,5 @@
+resource "grafana_org_invite" "alice" {
+  email = "alice@example.com"
+  name  = "Alice"
+  role  = "Editor"
+}
@@ -0,0 +1,2 @@
+terraform import grafana_org_user.name "{{ userID }}"
+terraform import grafana_org_user.name "{{ orgID }}:{{ userID }}"
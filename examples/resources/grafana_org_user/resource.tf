@@ -0,0 +1,15 @@
+resource "grafana_organization" "team" {
+  name = "Team"
+}
+
+resource "grafana_user" "alice" {
+  email    = "alice@example.com"
+  login    = "alice"
+  password = "my-password"
+}
+
+resource "grafana_org_user" "alice" {
+  org_id         = grafana_organization.team.org_id
+  login_or_email = grafana_user.alice.email
+  role           = "Editor"
+}
@@ -0,0 +1 @@
+terraform import grafana_role_permission_item.name "{{ orgID }}:{{ roleUID }}:{{ action }}:{{ scope }}"
@@ -0,0 +1,18 @@
+resource "grafana_role" "test" {
+  name                   = "Test Role"
+  uid                    = "testrole"
+  auto_increment_version = true
+  manage_permissions     = false
+}
+
+resource "grafana_role_permission_item" "read_users" {
+  role_uid = grafana_role.test.uid
+  action   = "org.users:read"
+  scope    = "users:*"
+}
+
+resource "grafana_role_permission_item" "read_folders" {
+  role_uid = grafana_role.test.uid
+  action   = "folders:read"
+  scope    = "folders:*"
+}
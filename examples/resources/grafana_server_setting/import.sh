@@ -0,0 +1 @@
+terraform import grafana_server_setting.name "{{ section }}:{{ key }}"
@@ -0,0 +1,5 @@
+resource "grafana_server_setting" "saml_single_logout" {
+  section = "auth.saml"
+  key     = "single_logout"
+  value   = "true"
+}
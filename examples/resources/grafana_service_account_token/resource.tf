@@ -14,6 +14,18 @@ resource "grafana_service_account_token" "bar" {
   seconds_to_live    = 30
 }
 
+// Rotated every 25 days, the previous key stays valid for 2 more days
+resource "grafana_service_account_token" "rotated" {
+  name               = "key_rotated"
+  service_account_id = grafana_service_account.test.id
+  seconds_to_live    = 2592000
+
+  rotation {
+    rotate_after = "25d"
+    overlap      = "2d"
+  }
+}
+
 
 output "service_account_token_foo_key_only" {
   value     = grafana_service_account_token.foo.key
@@ -0,0 +1,16 @@
+resource "grafana_team" "platform" {
+  name = "Platform"
+  // The members are managed by grafana_team_member resources
+}
+
+resource "grafana_user" "alice" {
+  email    = "alice@example.com"
+  login    = "alice"
+  password = "my-password"
+}
+
+resource "grafana_team_member" "alice" {
+  team_id = grafana_team.platform.id
+  user_id = grafana_user.alice.user_id
+  admin   = true
+}
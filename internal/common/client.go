@@ -33,7 +33,11 @@ type Client struct {
 	CloudProviderAPI     *cloudproviderapi.Client
 	ConnectionsAPIClient *connectionsapi.Client
 
+	// DashboardIgnoreJSONPaths is the provider-level default of the `ignore_json_paths` attribute of dashboards.
+	DashboardIgnoreJSONPaths []string
+
 	alertingMutex sync.Mutex
+	roleMutex     sync.Mutex
 }
 
 // WithAlertingMutex is a helper function that wraps a CRUD Terraform function with a mutex.
@@ -46,6 +50,17 @@ func WithAlertingMutex[T schema.CreateContextFunc | schema.ReadContextFunc | sch
 	}
 }
 
+// WithRoleMutex is a helper function that wraps a CRUD Terraform function with a mutex,
+// so that the permissions of roles are not modified concurrently.
+func WithRoleMutex[T schema.CreateContextFunc | schema.ReadContextFunc | schema.UpdateContextFunc | schema.DeleteContextFunc](f T) T {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		lock := &meta.(*Client).roleMutex
+		lock.Lock()
+		defer lock.Unlock()
+		return f(ctx, d, meta)
+	}
+}
+
 func (c *Client) GrafanaSubpath(path string) string {
 	path = strings.TrimPrefix(path, c.GrafanaAPIURLParsed.Path)
 	return c.GrafanaAPIURLParsed.JoinPath(path).String()
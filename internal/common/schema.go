@@ -41,7 +41,10 @@ func CloneResourceSchemaForDatasource(r *schema.Resource, updates map[string]*sc
 		clone[k].ValidateFunc = nil
 		clone[k].ConflictsWith = nil
 		clone[k].ExactlyOneOf = nil
+		clone[k].AtLeastOneOf = nil
+		clone[k].RequiredWith = nil
 		clone[k].MaxItems = 0
+		clone[k].MinItems = 0
 	}
 	for k, v := range updates {
 		if v == nil {
@@ -0,0 +1,129 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AddServiceAccountTokenRotationSchema adds the `rotation` block of service account tokens, and the attributes it computes, to a resource schema.
+func AddServiceAccountTokenRotationSchema(resourceSchema map[string]*schema.Schema) {
+	resourceSchema["rotation"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Rotates the token without downtime. Once the token is older than `rotate_after`, the next apply creates a new token and keeps the previous one in `previous_key`. " +
+			"The previous token is deleted by the first apply after the `overlap` period.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rotate_after": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The age of the token after which it's rotated, as a duration (ex: `720h`, `30d`).",
+					ValidateDiagFunc: ValidateDurationWithDays,
+				},
+				"overlap": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "0s",
+					Description:      "How long the previous token is kept after a rotation, as a duration (ex: `24h`, `2d`).",
+					ValidateDiagFunc: ValidateDurationWithDays,
+				},
+			},
+		},
+	}
+	resourceSchema["created_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The creation date of the current token.",
+	}
+	resourceSchema["previous_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The key of the previous token, during the overlap period of a rotation.",
+	}
+	resourceSchema["previous_token_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the previous token, during the overlap period of a rotation.",
+	}
+}
+
+// ServiceAccountTokenRotation is the configuration of the `rotation` block of service account tokens.
+type ServiceAccountTokenRotation struct {
+	RotateAfter time.Duration
+	Overlap     time.Duration
+}
+
+// ServiceAccountTokenRotationFromConfig returns the `rotation` block, or nil if it's not set.
+func ServiceAccountTokenRotationFromConfig(d interface{ Get(string) interface{} }) (*ServiceAccountTokenRotation, error) {
+	items := d.Get("rotation").([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return nil, nil
+	}
+	item := items[0].(map[string]interface{})
+
+	rotateAfter, err := strfmt.ParseDuration(item["rotate_after"].(string))
+	if err != nil {
+		return nil, err
+	}
+	overlap, err := strfmt.ParseDuration(item["overlap"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceAccountTokenRotation{RotateAfter: rotateAfter, Overlap: overlap}, nil
+}
+
+// RotatedServiceAccountTokenName returns the name of a token created by a rotation. Token names must be unique per service account.
+func RotatedServiceAccountTokenName(name string, createdAt time.Time) string {
+	return fmt.Sprintf("%s-%d", name, createdAt.Unix())
+}
+
+// IsRotatedServiceAccountTokenName reports whether a token name is the one given to the current token by its last rotation.
+// The name is derived from the configured name and the `created_at` attribute of the state.
+func IsRotatedServiceAccountTokenName(d *schema.ResourceData, tokenName string) bool {
+	createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	return err == nil && tokenName == RotatedServiceAccountTokenName(d.Get("name").(string), createdAt)
+}
+
+// ServiceAccountTokenRotationCustomizeDiff is the CustomizeDiff of service account tokens. It plans a new key once the token must be rotated,
+// and the removal of the previous key once the overlap period is over.
+func ServiceAccountTokenRotationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rotation, err := ServiceAccountTokenRotationFromConfig(d)
+	if err != nil {
+		return err
+	}
+	if rotation != nil && d.NewValueKnown("seconds_to_live") {
+		if ttl := time.Duration(d.Get("seconds_to_live").(int)) * time.Second; ttl > 0 && rotation.RotateAfter+rotation.Overlap > ttl {
+			return fmt.Errorf("rotation.rotate_after plus rotation.overlap (%s) must not exceed seconds_to_live (%s), or the token expires before the previous one is deleted", rotation.RotateAfter+rotation.Overlap, ttl)
+		}
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	now := time.Now()
+	if rotation != nil && !createdAt.IsZero() && !now.Before(createdAt.Add(rotation.RotateAfter)) {
+		for _, key := range []string{"key", "created_at", "expiration", "has_expired", "previous_key", "previous_token_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The previous token is deleted after the overlap period, or right away if the rotation is disabled
+	if d.Get("previous_token_id").(string) != "" && (rotation == nil || !now.Before(createdAt.Add(rotation.Overlap))) {
+		for _, key := range []string{"previous_key", "previous_token_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
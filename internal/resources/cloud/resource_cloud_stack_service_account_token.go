@@ -28,7 +28,9 @@ Required access policy scopes:
 
 		CreateContext: withClient[schema.CreateContextFunc](stackServiceAccountTokenCreate),
 		ReadContext:   withClient[schema.ReadContextFunc](stackServiceAccountTokenRead),
+		UpdateContext: withClient[schema.UpdateContextFunc](stackServiceAccountTokenUpdate),
 		DeleteContext: withClient[schema.DeleteContextFunc](stackServiceAccountTokenDelete),
+		CustomizeDiff: common.ServiceAccountTokenRotationCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"stack_slug": {
@@ -72,6 +74,7 @@ Required access policy scopes:
 			},
 		},
 	}
+	common.AddServiceAccountTokenRotationSchema(schema.Schema)
 
 	return common.NewLegacySDKResource(
 		common.CategoryCloud,
@@ -110,11 +113,66 @@ func stackServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("created_at", time.Now().UTC().Format(time.RFC3339))
 
 	// Fill the true resource's state by performing a read
 	return stackServiceAccountTokenRead(ctx, d, cloudClient)
 }
 
+func stackServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, cloudClient *gcom.APIClient) diag.Diagnostics {
+	// The planned values of rotated attributes are unknown, so the previous values are read from the state
+	oldKey, _ := d.GetChange("key")
+	oldPreviousTokenID, _ := d.GetChange("previous_token_id")
+	if !d.HasChange("key") && !d.HasChange("previous_token_id") {
+		return stackServiceAccountTokenRead(ctx, d, cloudClient)
+	}
+
+	if err := waitForStackReadinessFromSlug(ctx, 5*time.Minute, d.Get("stack_slug").(string), cloudClient); err != nil {
+		return err
+	}
+
+	stackSlug := d.Get("stack_slug").(string)
+	serviceAccountID, err := getStackServiceAccountID(d.Get("service_account_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The previous token is deleted once its overlap period is over, or when the token is rotated again
+	if previousTokenID := oldPreviousTokenID.(string); previousTokenID != "" {
+		httpResp, err := cloudClient.InstancesAPI.DeleteInstanceServiceAccountToken(ctx, stackSlug, strconv.FormatInt(serviceAccountID, 10), previousTokenID).
+			XRequestId(ClientRequestID()).
+			Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+			return diag.Errorf("failed to delete previous token %s: %s", previousTokenID, err)
+		}
+		d.Set("previous_key", "")
+		d.Set("previous_token_id", "")
+	}
+
+	if d.HasChange("key") {
+		createdAt := time.Now().UTC()
+		req := gcom.PostInstanceServiceAccountTokensRequest{
+			Name:          common.RotatedServiceAccountTokenName(d.Get("name").(string), createdAt),
+			SecondsToLive: common.Ref(int32(d.Get("seconds_to_live").(int))), //nolint:gosec
+		}
+		resp, _, err := cloudClient.InstancesAPI.PostInstanceServiceAccountTokens(ctx, stackSlug, strconv.FormatInt(serviceAccountID, 10)).
+			PostInstanceServiceAccountTokensRequest(req).
+			XRequestId(ClientRequestID()).
+			Execute()
+		if err != nil {
+			return diag.Errorf("failed to rotate token: %s", err)
+		}
+
+		d.Set("previous_key", oldKey)
+		d.Set("previous_token_id", d.Id())
+		d.SetId(strconv.FormatInt(*resp.Id, 10))
+		d.Set("key", resp.Key)
+		d.Set("created_at", createdAt.Format(time.RFC3339))
+	}
+
+	return stackServiceAccountTokenRead(ctx, d, cloudClient)
+}
+
 func stackServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, cloudClient *gcom.APIClient) diag.Diagnostics {
 	stackSlug := d.Get("stack_slug").(string)
 	serviceAccountID, err := getStackServiceAccountID(d.Get("service_account_id").(string))
@@ -138,9 +196,15 @@ func stackServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, c
 	for _, key := range response {
 		if id == *key.Id {
 			d.SetId(strconv.FormatInt(*key.Id, 10))
-			err = d.Set("name", key.Name)
-			if err != nil {
-				return diag.FromErr(err)
+			// Rotated tokens are named after the configured name
+			if key.Name == nil || !common.IsRotatedServiceAccountTokenName(d, *key.Name) {
+				err = d.Set("name", key.Name)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			if d.Get("created_at").(string) == "" && key.Created != nil {
+				d.Set("created_at", key.Created.UTC().Format(time.RFC3339))
 			}
 			if key.Expiration != nil && !key.Expiration.IsZero() {
 				err = d.Set("expiration", key.Expiration.String())
@@ -171,7 +235,19 @@ func stackServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData,
 	_, err = cloudClient.InstancesAPI.DeleteInstanceServiceAccountToken(ctx, stackSlug, strconv.FormatInt(serviceAccountID, 10), d.Id()).
 		XRequestId(ClientRequestID()).
 		Execute()
-	return diag.FromErr(err)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if previousTokenID := d.Get("previous_token_id").(string); previousTokenID != "" {
+		httpResp, err := cloudClient.InstancesAPI.DeleteInstanceServiceAccountToken(ctx, stackSlug, strconv.FormatInt(serviceAccountID, 10), previousTokenID).
+			XRequestId(ClientRequestID()).
+			Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
 }
 
 func getStackServiceAccountID(id string) (int64, error) {
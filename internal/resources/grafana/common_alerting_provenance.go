@@ -0,0 +1,65 @@
+package grafana
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provenances set by the alerting provisioning API.
+// Resources created in the UI have no provenance. Other values (ex: `file`) are set by other provisioning mechanisms.
+const (
+	provenanceNone = ""
+	provenanceAPI  = "api"
+)
+
+// provenanceTransitionFunc returns true if Grafana allows changing the provenance of an existing resource from one value to another.
+type provenanceTransitionFunc func(from, to string) bool
+
+// relaxedProvenanceTransition matches the validation of contact points and mute timings.
+// Any provenance can be set, but it can't be removed once set.
+func relaxedProvenanceTransition(from, to string) bool {
+	return from == provenanceNone || to != provenanceNone
+}
+
+// ruleGroupProvenanceTransition matches the validation of rule groups.
+// Resources without provenance can be adopted, and the API provenance can be removed. Other provenances are locked.
+func ruleGroupProvenanceTransition(from, to string) bool {
+	return from == to || from == provenanceNone || (from == provenanceAPI && to == provenanceNone)
+}
+
+func provenanceAttribute() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The provenance of the resource, as reported by Grafana. `api` when managed by Terraform, empty when provenance is disabled, and another value (ex: `file`) when provisioned by other means.",
+	}
+}
+
+// provenanceCustomizeDiff plans the provenance change needed to match `disable_provenance`.
+// This allows adopting resources that were created in the UI or provisioned from files (ex: after an import).
+// If Grafana doesn't allow the change in-place, a change of `disable_provenance` recreates the resource.
+func provenanceCustomizeDiff(canTransition provenanceTransitionFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		current := d.Get("provenance").(string)
+		desired := provenanceAPI
+		if d.Get("disable_provenance").(bool) {
+			desired = provenanceNone
+		}
+		if current == desired {
+			return nil
+		}
+
+		if canTransition(current, desired) {
+			return d.SetNew("provenance", desired)
+		}
+		if d.HasChange("disable_provenance") {
+			return d.ForceNew("disable_provenance")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+)
+
+// grafanaAPIRequest calls a Grafana HTTP API endpoint which isn't covered by the OpenAPI client.
+// The request goes through the client's transport, so auth, retries and the org ID header are handled like any generated operation.
+// The path is relative to the `/api` base path and may contain a query string. Values must already be escaped.
+// If the response is not a 2xx, a *runtime.APIError is returned, so the usual helpers (ex: common.CheckReadError) can be used.
+func grafanaAPIRequest(ctx context.Context, client *goapi.GrafanaHTTPAPI, method, path string, body, result interface{}) error {
+	opName := fmt.Sprintf("[%s /api%s]", method, path)
+
+	// The transport unescapes the path pattern before building the URL, so escaped segments (ex: a name containing `/` or `%`)
+	// are passed as path params instead, which are escaped again by the transport.
+	pathPattern, query, _ := strings.Cut(path, "?")
+	segments := strings.Split(pathPattern, "/")
+	pathParams := map[string]string{}
+	for i, segment := range segments {
+		if !strings.Contains(segment, "%") {
+			continue
+		}
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			return err
+		}
+		name := fmt.Sprintf("segment%d", i)
+		pathParams[name] = value
+		segments[i] = "{" + name + "}"
+	}
+	pathPattern = strings.Join(segments, "/")
+	if query != "" {
+		pathPattern += "?" + query
+	}
+
+	_, err := client.Transport.Submit(&runtime.ClientOperation{
+		ID:                 opName,
+		Method:             method,
+		PathPattern:        pathPattern,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+			for name, value := range pathParams {
+				if err := r.SetPathParam(name, value); err != nil {
+					return err
+				}
+			}
+			if body == nil {
+				return nil
+			}
+			return r.SetBodyParam(body)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code()/100 != 2 {
+				return nil, runtime.NewAPIError(opName, readAPIErrorMessage(response), response.Code())
+			}
+			if result == nil {
+				return nil, nil
+			}
+			if err := consumer.Consume(response.Body(), result); err != nil && !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return nil, nil
+		}),
+		Context: ctx,
+	})
+	return err
+}
+
+// readAPIErrorMessage extracts the message from a Grafana error response, falling back to the raw body.
+func readAPIErrorMessage(response runtime.ClientResponse) error {
+	body, err := io.ReadAll(response.Body())
+	if err != nil {
+		return err
+	}
+
+	var errorBody struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errorBody); err == nil && errorBody.Message != "" {
+		return errors.New(errorBody.Message)
+	} else if err == nil && errorBody.Error != "" {
+		return errors.New(errorBody.Error)
+	}
+
+	return errors.New(strings.TrimSpace(string(body)))
+}
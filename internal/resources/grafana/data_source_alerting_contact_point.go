@@ -0,0 +1,53 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceContactPoint() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/fundamentals/notifications/contact-points/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+		ReadContext: dataSourceContactPointRead,
+		Schema: common.CloneResourceSchemaForDatasource(resourceContactPoint().Schema, map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the contact point.",
+			},
+			"disable_provenance":    nil,
+			"test_on_apply":         nil,
+			"test_failure_severity": nil,
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_contact_point", schema)
+}
+
+func dataSourceContactPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	name := d.Get("name").(string)
+
+	points, err := getContactPointsByName(client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(points) == 0 {
+		return diag.Errorf("no contact point with name %q", name)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, name))
+	if err := d.Set("org_id", strconv.FormatInt(orgID, 10)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(packContactPoints(points, d))
+}
@@ -0,0 +1,38 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceContactPoint_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var points models.ContactPoints
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingContactPointCheckExists.destroyed(&points, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_contact_point/data-source.tf", map[string]string{
+					"My Contact Point": name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					checkAlertingContactPointExistsWithLength("grafana_contact_point.my_contact_point", &points, 1),
+					resource.TestCheckResourceAttr("data.grafana_contact_point.from_name", "name", name),
+					resource.TestMatchResourceAttr("data.grafana_contact_point.from_name", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("data.grafana_contact_point.from_name", "email.#", "1"),
+					resource.TestCheckResourceAttrPair("data.grafana_contact_point.from_name", "email.0.uid", "grafana_contact_point.my_contact_point", "email.0.uid"),
+					resource.TestCheckResourceAttr("data.grafana_contact_point.from_name", "email.0.addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_contact_point.from_name", "provenance", "api"),
+				),
+			},
+		},
+	})
+}
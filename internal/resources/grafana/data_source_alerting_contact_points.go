@@ -0,0 +1,95 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceContactPoints() *common.DataSource {
+	schema := &schema.Resource{
+		ReadContext: readContactPoints,
+		Description: `
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/fundamentals/notifications/contact-points/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"contact_points": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The contact points of the organization, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the contact point.",
+						},
+						"integration": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The integrations (notifiers) of the contact point.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The UID of the integration.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the integration. Ex: `email`, `slack`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_contact_points", schema)
+}
+
+func readContactPoints(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API returns one item per integration, group them by contact point name
+	var names []string
+	integrations := map[string][]interface{}{}
+	for _, p := range resp.Payload {
+		if _, ok := integrations[p.Name]; !ok {
+			names = append(names, p.Name)
+		}
+		integrations[p.Name] = append(integrations[p.Name], map[string]interface{}{
+			"uid":  p.UID,
+			"type": *p.Type,
+		})
+	}
+	sort.Strings(names)
+
+	points := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		points = append(points, map[string]interface{}{
+			"name":        name,
+			"integration": integrations[name],
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "contact_points"))
+	return diag.FromErr(d.Set("contact_points", points))
+}
@@ -0,0 +1,51 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceMuteTiming() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/configure-notifications/mute-timings/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#mute-timings)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+		ReadContext: dataSourceMuteTimingRead,
+		Schema: common.CloneResourceSchemaForDatasource(resourceMuteTiming().Schema, map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the mute timing.",
+			},
+			"disable_provenance": nil,
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_mute_timing", schema)
+}
+
+func dataSourceMuteTimingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	name := d.Get("name").(string)
+
+	mt, err := getMuteTiming(ctx, client, name)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return diag.Errorf("no mute timing with name %q", name)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, mt.Name))
+	if err := d.Set("org_id", strconv.FormatInt(orgID, 10)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(packMuteTiming(mt, d))
+}
@@ -0,0 +1,44 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceNotificationPolicy() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Reads the notification policy tree of an organization.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/fundamentals/notifications/notification-policies/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#notification-policies)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+		ReadContext: dataSourceNotificationPolicyRead,
+		Schema: common.CloneResourceSchemaForDatasource(resourceNotificationPolicy().Schema, map[string]*schema.Schema{
+			"org_id":             orgIDAttribute(),
+			"disable_provenance": nil,
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_notification_policy", schema)
+}
+
+func dataSourceNotificationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, PolicySingletonID))
+	if err := d.Set("org_id", strconv.FormatInt(orgID, 10)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(packNotifPolicy(resp.Payload, d))
+}
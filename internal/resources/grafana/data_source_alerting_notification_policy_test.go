@@ -0,0 +1,27 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceNotificationPolicy_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	// The notification policy is a singleton, so this test isn't run in parallel with the resource tests.
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_notification_policy/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.grafana_notification_policy.current", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttrSet("data.grafana_notification_policy.current", "contact_point"),
+					resource.TestCheckResourceAttrSet("data.grafana_notification_policy.current", "group_by.#"),
+				),
+			},
+		},
+	})
+}
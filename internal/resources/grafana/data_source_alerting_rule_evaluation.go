@@ -0,0 +1,204 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceAlertRuleEvaluation() *common.DataSource {
+	schema := &schema.Resource{
+		ReadContext: readAlertRuleEvaluation,
+		Description: `
+Evaluates a Grafana-managed alert rule without saving it, and returns the alert instances it produces.
+This can be used to assert that a rule would fire or not (ex: in ` + "`terraform test`" + `).
+The rule is defined the same way as a ` + "`rule`" + ` block of the ` + "`grafana_rule_group`" + ` resource.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/alerting-rules/create-grafana-managed-rule/)
+
+This data source requires Grafana 10.0.0 or later.
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the folder the rule is evaluated in.",
+			},
+			"rule_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the rule group the rule is evaluated in.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the alert rule. It's set as the `alertname` label of the instances.",
+			},
+			"no_data_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "NoData",
+				Description: "Describes what state to enter when the rule's query returns No Data. Options are OK, NoData, KeepLast, and Alerting.",
+			},
+			"exec_err_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Alerting",
+				Description: "Describes what state to enter when the rule's query is invalid and the rule cannot be executed. Options are OK, Error, KeepLast, and Alerting.",
+			},
+			"condition": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The `ref_id` of the query node in the `data` field to use as the alert condition.",
+			},
+			"data": alertRuleDataSchema(),
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Key-value pairs to attach to the alert rule.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Key-value pairs of metadata to attach to the alert rule. Templates are rendered in the returned instances.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"firing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one instance isn't in the `Normal` state.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alert instances produced by the evaluation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the instance. One of `Alerting`, `NoData`, `Error` or `Normal`.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The labels of the instance.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The annotations of the instance, with templates rendered.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_alert_rule_evaluation", schema)
+}
+
+// alertRuleTestAlert is an alert returned by the rule testing API.
+type alertRuleTestAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+}
+
+func readAlertRuleEvaluation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	data, err := unpackRuleData(d.Get("data"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folderUID := d.Get("folder_uid").(string)
+	body := map[string]interface{}{
+		"folderUid": folderUID,
+		"ruleGroup": d.Get("rule_group").(string),
+		"rule": map[string]interface{}{
+			"labels":      unpackMap(d.Get("labels")),
+			"annotations": unpackMap(d.Get("annotations")),
+			"grafana_alert": map[string]interface{}{
+				"title":          d.Get("name").(string),
+				"condition":      d.Get("condition").(string),
+				"data":           data,
+				"no_data_state":  d.Get("no_data_state").(string),
+				"exec_err_state": d.Get("exec_err_state").(string),
+			},
+		},
+	}
+	var alerts []alertRuleTestAlert
+	if err := grafanaAPIRequest(ctx, client, "POST", "/v1/rule/test/grafana", body, &alerts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Sort the instances by labels for a stable output
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return labelsKey(alerts[i].Labels) < labelsKey(alerts[j].Labels)
+	})
+
+	firing := false
+	instances := make([]interface{}, 0, len(alerts))
+	for _, alert := range alerts {
+		state := alertRuleTestAlertState(alert)
+		if state != "Normal" {
+			firing = true
+		}
+		instances = append(instances, map[string]interface{}{
+			"state":       state,
+			"labels":      alert.Labels,
+			"annotations": alert.Annotations,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, folderUID))
+	d.Set("firing", firing)
+	return diag.FromErr(d.Set("instances", instances))
+}
+
+// alertRuleTestAlertState infers the state of an instance from the alert sent by Grafana.
+// NoData and Error instances are renamed by Grafana. Resolved (Normal) instances end when they start.
+func alertRuleTestAlertState(alert alertRuleTestAlert) string {
+	switch alert.Labels["alertname"] {
+	case "DatasourceNoData":
+		return "NoData"
+	case "DatasourceError":
+		return "Error"
+	}
+	if !alert.EndsAt.After(alert.StartsAt) {
+		return "Normal"
+	}
+	return "Alerting"
+}
+
+// labelsKey returns a string representation of labels, sorted by name.
+func labelsKey(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
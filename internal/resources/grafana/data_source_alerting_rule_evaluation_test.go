@@ -0,0 +1,37 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceAlertRuleEvaluation_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_alert_rule_evaluation/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "firing", "true"),
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "instances.0.state", "Alerting"),
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "instances.0.labels.alertname", "High value"),
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "instances.0.labels.severity", "critical"),
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "instances.0.annotations.summary", "The value is 1"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_alert_rule_evaluation/data-source.tf", map[string]string{
+					`"2 > 1"`: `"1 > 2"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_alert_rule_evaluation.high_value", "firing", "false"),
+				),
+			},
+		},
+	})
+}
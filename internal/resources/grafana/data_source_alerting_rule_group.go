@@ -0,0 +1,57 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceRuleGroup() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/fundamentals/alert-rules/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#alert-rules)
+
+This data source requires Grafana 9.1.0 or later.
+`,
+		ReadContext: dataSourceRuleGroupRead,
+		Schema: common.CloneResourceSchemaForDatasource(resourceRuleGroup().Schema, map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule group.",
+			},
+			"folder_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the folder that the group belongs to.",
+			},
+			"disable_provenance": nil,
+		}),
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_rule_group", schema)
+}
+
+func dataSourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	folderUID := d.Get("folder_uid").(string)
+	name := d.Get("name").(string)
+
+	resp, err := client.Provisioning.GetAlertRuleGroup(name, folderUID)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return diag.Errorf("no rule group with name %q in folder %q", name, folderUID)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceRuleGroupID.Make(orgID, folderUID, name))
+	if err := d.Set("org_id", strconv.FormatInt(orgID, 10)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(packRuleGroup(client, resp.Payload, d))
+}
@@ -0,0 +1,39 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRuleGroup_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var group models.AlertRuleGroup
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingRuleGroupCheckExists.destroyed(&group, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_rule_group/data-source.tf", map[string]string{
+					"My Rule Folder": name,
+					"My Rule Group":  name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					alertingRuleGroupCheckExists.exists("grafana_rule_group.my_rule_group", &group),
+					resource.TestCheckResourceAttr("data.grafana_rule_group.from_name", "name", name),
+					resource.TestCheckResourceAttrPair("data.grafana_rule_group.from_name", "id", "grafana_rule_group.my_rule_group", "id"),
+					resource.TestCheckResourceAttr("data.grafana_rule_group.from_name", "interval_seconds", "60"),
+					resource.TestCheckResourceAttr("data.grafana_rule_group.from_name", "rule.#", "1"),
+					resource.TestCheckResourceAttrPair("data.grafana_rule_group.from_name", "rule.0.uid", "grafana_rule_group.my_rule_group", "rule.0.uid"),
+					resource.TestCheckResourceAttr("data.grafana_rule_group.from_name", "rule.0.name", "My Alert Rule"),
+				),
+			},
+		},
+	})
+}
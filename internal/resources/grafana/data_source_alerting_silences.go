@@ -0,0 +1,150 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceSilences() *common.DataSource {
+	schema := &schema.Resource{
+		ReadContext: readSilences,
+		Description: `
+Lists the active Grafana Alerting silences.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/configure-notifications/create-silence/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_alertmanager/)
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return silences matching all of these matchers, in the Alertmanager syntax. Ex: `alertname=\"HighCPU\"` or `team=~\"backend.*\"`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_pending": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also return silences that haven't started yet.",
+			},
+			"silences": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The silences, sorted by end time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The silence ID.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the silence. Either `active` or `pending`.",
+						},
+						"starts_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC 3339-formatted time at which the silence starts.",
+						},
+						"ends_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC 3339-formatted time at which the silence ends.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The comment of the silence.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The author of the silence.",
+						},
+						"matcher": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The matchers of the silence.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the label to match against.",
+									},
+									"match": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The operator to apply when matching values of the given label.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The label value to match against.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryAlerting, "grafana_alerting_silences", schema)
+}
+
+func readSilences(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	silences, err := getSilences(ctx, client, common.ListToStringSlice(d.Get("filter").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The API returns silences grouped by state, sort them by end time for a stable output
+	sort.SliceStable(silences, func(i, j int) bool {
+		if silences[i].EndsAt.Equal(silences[j].EndsAt) {
+			return silences[i].ID < silences[j].ID
+		}
+		return silences[i].EndsAt.Before(silences[j].EndsAt)
+	})
+
+	includePending := d.Get("include_pending").(bool)
+	items := make([]interface{}, 0, len(silences))
+	for _, silence := range silences {
+		if silence.Status == nil {
+			continue
+		}
+		state := silence.Status.State
+		if state != silenceStateActive && !(includePending && state == silenceStatePending) {
+			continue
+		}
+
+		matchers := make([]interface{}, 0, len(silence.Matchers))
+		for _, m := range silence.Matchers {
+			matchers = append(matchers, packSilenceMatcher(m))
+		}
+		items = append(items, map[string]interface{}{
+			"id":         silence.ID,
+			"state":      state,
+			"starts_at":  silence.StartsAt.UTC().Format(time.RFC3339),
+			"ends_at":    silence.EndsAt.UTC().Format(time.RFC3339),
+			"comment":    silence.Comment,
+			"created_by": silence.CreatedBy,
+			"matcher":    matchers,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "silences"))
+	return diag.FromErr(d.Set("silences", items))
+}
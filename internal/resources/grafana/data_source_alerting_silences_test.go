@@ -0,0 +1,36 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceSilences_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	// The silences of the other tests don't match a random service
+	service := acctest.RandomWithPrefix("database")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_alerting_silences/data-source.tf", map[string]string{
+					`value = "database"`: `value = "` + service + `"`,
+					`\"database\"`:       `\"` + service + `\"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_alerting_silences.database", "silences.#", "1"),
+					resource.TestCheckResourceAttrSet("data.grafana_alerting_silences.database", "silences.0.id"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_silences.database", "silences.0.state", "active"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_silences.database", "silences.0.comment", "Database maintenance window"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_silences.database", "silences.0.matcher.0.label", "service"),
+					resource.TestCheckResourceAttr("data.grafana_alerting_silences.database", "silences.0.matcher.0.value", service),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package grafana
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafana/grafana-openapi-client-go/client/dashboard_versions"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceDashboardVersions() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the versions of a dashboard, from the most recent to the oldest.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/build-dashboards/manage-version-history/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard_versions/)
+`,
+		ReadContext: readDashboardVersions,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the dashboard.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The maximum number of versions to return. If not set, Grafana's default limit is used.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the dashboard, from the most recent to the oldest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the version.",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version number.",
+						},
+						"parent_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version this version was created from.",
+						},
+						"restored_from": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version this version was restored from, if it was created by a restore. Otherwise `0`.",
+						},
+						"created": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC 3339-formatted time at which the version was created.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the author of the version.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message of the version.",
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_dashboard_versions", schema)
+}
+
+func readDashboardVersions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	uid := d.Get("dashboard_uid").(string)
+
+	params := dashboard_versions.NewGetDashboardVersionsByUIDParams().WithUID(uid)
+	if limit := int64(d.Get("limit").(int)); limit > 0 {
+		params.SetLimit(&limit)
+	}
+	resp, err := client.DashboardVersions.GetDashboardVersionsByUID(params)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions := make([]interface{}, 0, len(resp.Payload))
+	for _, v := range resp.Payload {
+		versions = append(versions, map[string]interface{}{
+			"id":             v.ID,
+			"version":        v.Version,
+			"parent_version": v.ParentVersion,
+			"restored_from":  v.RestoredFrom,
+			"created":        time.Time(v.Created).UTC().Format(time.RFC3339),
+			"created_by":     v.CreatedBy,
+			"message":        v.Message,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, uid))
+	return diag.FromErr(d.Set("versions", versions))
+}
@@ -0,0 +1,48 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDashboardVersions_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_dashboard_versions/data-source.tf", map[string]string{
+					"Production Overview": name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.0.version", "1"),
+					resource.TestCheckResourceAttrSet("data.grafana_dashboard_versions.test", "versions.0.created"),
+					resource.TestCheckResourceAttrSet("data.grafana_dashboard_versions.test", "versions.0.created_by"),
+				),
+			},
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_dashboard_versions/data-source.tf", map[string]string{
+					"Production Overview": name + "-updated",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.0.version", "2"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.0.parent_version", "1"),
+					resource.TestCheckResourceAttr("data.grafana_dashboard_versions.test", "versions.1.version", "1"),
+				),
+			},
+		},
+	})
+}
@@ -29,8 +29,13 @@ func datasourceDatasource() *common.DataSource {
 			},
 			"secure_json_data_encoded": nil,
 			"http_headers":             nil,
+			"health_check":             nil,
 		}),
 	}
+	// The typed config blocks are only used to write the config. The data source exposes the whole config in `json_data_encoded`.
+	for _, c := range datasourceTypedConfigs {
+		delete(schema.Schema, c.attr)
+	}
 	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_data_source", schema)
 }
 
@@ -0,0 +1,115 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/go-openapi/runtime"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	datasourceHealthCheckOff   = "off"
+	datasourceHealthCheckWarn  = "warn"
+	datasourceHealthCheckError = "error"
+
+	datasourceHealthStatusOK    = "OK"
+	datasourceHealthStatusError = "ERROR"
+)
+
+var datasourceHealthCheckModes = []string{datasourceHealthCheckOff, datasourceHealthCheckWarn, datasourceHealthCheckError}
+
+type datasourceHealth struct {
+	Status  string      `json:"status"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details"`
+}
+
+func datasourceDatasourceHealth() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Runs the health check of a data source, as done by the "Save & test" button of the UI.
+A failed check doesn't fail the read, so the status can be asserted in ` + "`check`" + ` blocks or postconditions.
+
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/#check-data-source-health)
+`,
+		ReadContext: datasourceDatasourceHealthRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UID of the data source.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the health check. `OK` if the data source is healthy, usually `ERROR` otherwise.",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the status is `OK`.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message returned by the data source plugin.",
+			},
+			"details_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The details returned by the data source plugin, as JSON. Empty if there are none.",
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_data_source_health", schema)
+}
+
+func datasourceDatasourceHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	uid := d.Get("uid").(string)
+
+	health, err := getDatasourceHealth(ctx, client, uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	details := ""
+	if health.Details != nil {
+		detailsBytes, err := json.Marshal(health.Details)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		details = string(detailsBytes)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, uid))
+	d.Set("status", health.Status)
+	d.Set("healthy", health.Status == datasourceHealthStatusOK)
+	d.Set("message", health.Message)
+	d.Set("details_json", details)
+	return nil
+}
+
+// getDatasourceHealth runs the health check of a data source.
+// Failed checks are returned as a 400 by Grafana. They are returned as a health status, not as an error.
+func getDatasourceHealth(ctx context.Context, client *goapi.GrafanaHTTPAPI, uid string) (*datasourceHealth, error) {
+	var health datasourceHealth
+	err := grafanaAPIRequest(ctx, client, "GET", "/datasources/uid/"+url.PathEscape(uid)+"/health", nil, &health)
+	if apiErr, ok := err.(*runtime.APIError); ok && apiErr.IsCode(400) {
+		return &datasourceHealth{
+			Status:  datasourceHealthStatusError,
+			Message: fmt.Sprint(apiErr.Response),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
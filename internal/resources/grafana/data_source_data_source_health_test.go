@@ -0,0 +1,67 @@
+package grafana_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDatasourceHealth_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var dataSource models.DataSource
+	name := acctest.RandString(10)
+	replaces := map[string]string{
+		`"testdata"`:   `"` + name + `-testdata"`,
+		`"prometheus"`: `"` + name + `-prometheus"`,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_data_source_health/data-source.tf", replaces),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.testdata", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.testdata", "health_check", "error"),
+					resource.TestCheckResourceAttr("data.grafana_data_source_health.prometheus", "status", "ERROR"),
+					resource.TestCheckResourceAttr("data.grafana_data_source_health.prometheus", "healthy", "false"),
+					resource.TestCheckResourceAttrSet("data.grafana_data_source_health.prometheus", "message"),
+				),
+			},
+			{
+				ImportState:             true,
+				ResourceName:            "grafana_data_source.testdata",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"health_check"},
+			},
+		},
+	})
+}
+
+func TestAccDataSource_healthCheckError(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source" "prometheus" {
+					type         = "prometheus"
+					name         = "` + name + `"
+					url          = "http://prometheus.invalid:9090"
+					health_check = "error"
+				}`,
+				ExpectError: regexp.MustCompile(`health check of data source ".+" failed with status ERROR`),
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceFeatureToggles() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the feature toggles enabled on the Grafana server. It can be used in preconditions, to check that the features required by a resource are enabled (for example: ` + "`alertingSimplifiedRouting`" + ` for the ` + "`notification_settings`" + ` of the rules of ` + "`grafana_rule_group`" + `).
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-grafana/feature-toggles/)
+`,
+		ReadContext: dataSourceFeatureTogglesRead,
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the enabled feature toggles.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_feature_toggles", schema)
+}
+
+func dataSourceFeatureTogglesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := OAPIGlobalClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The toggles are reported by the settings of the frontend, which are readable by any user
+	var settings struct {
+		FeatureToggles map[string]bool `json:"featureToggles"`
+	}
+	if err := grafanaAPIRequest(ctx, client, "GET", "/frontend/settings", nil, &settings); err != nil {
+		return diag.Errorf("failed to get the feature toggles: %s", err)
+	}
+
+	enabled := make([]string, 0, len(settings.FeatureToggles))
+	for name, isEnabled := range settings.FeatureToggles {
+		if isEnabled {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+
+	d.SetId("grafana_feature_toggles")
+	d.Set("enabled", enabled)
+	return nil
+}
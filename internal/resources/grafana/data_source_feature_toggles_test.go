@@ -0,0 +1,39 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceFeatureToggles_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.0.0") // alertingSimplifiedRouting is enabled by default since 11.0
+
+	var folder models.Folder
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             folderCheckExists.destroyed(&folder, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_feature_toggles/data-source.tf", map[string]string{
+					"My Alert Rule Folder": name + " Folder",
+					"My Contact Point":     name + " Contact Point",
+					"My Routed Rule Group": name + " Rule Group",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.rule_folder", &folder),
+					resource.TestCheckResourceAttr("data.grafana_feature_toggles.enabled", "id", "grafana_feature_toggles"),
+					// Enabled through the GF_FEATURE_TOGGLES_ENABLE variable of the test environment
+					resource.TestCheckTypeSetElemAttr("data.grafana_feature_toggles.enabled", "enabled.*", "nestedFolders"),
+					resource.TestCheckTypeSetElemAttr("data.grafana_feature_toggles.enabled", "enabled.*", "alertingSimplifiedRouting"),
+					resource.TestCheckResourceAttr("grafana_rule_group.routed", "rule.0.notification_settings.0.contact_point", name+" Contact Point"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package grafana
+
+import (
+	"context"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceFolderTree() *common.DataSource {
+	schema := &schema.Resource{
+		ReadContext: readFolderTree,
+		Description: `
+Returns the hierarchy of nested folders, either of the whole instance or under a given folder.
+Folders are listed depth-first, each one after its parent, with its full path.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/manage-dashboards/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/folder/)
+
+This data source requires the nestedFolders feature flag to be enabled on your Grafana instance.
+`,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"root_folder_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The UID of the folder to start from. The folder itself isn't included. If not set, the whole tree is returned.",
+			},
+			"folders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The folders of the tree, depth-first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The folder's unique identifier.",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The folder title.",
+						},
+						"parent_folder_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UID of the parent folder. Empty for folders at the root of the instance.",
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The depth of the folder, relative to `root_folder_uid`. Folders directly under the root have a depth of 0.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The titles of the folder and its parents, separated by `/`, from the root of the instance.",
+						},
+						"uid_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UIDs of the folder and its parents, separated by `/`, from the root of the instance.",
+						},
+						"children_uids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The UIDs of the direct subfolders.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_folder_tree", schema)
+}
+
+func readFolderTree(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	rootUID := d.Get("root_folder_uid").(string)
+
+	// Paths are always from the root of the instance, so the parents of the root folder are needed
+	var titles, uids []string
+	if rootUID != "" {
+		root, err := GetFolderByIDorUID(client.Folders, rootUID)
+		if err != nil {
+			return diag.Errorf("failed to get folder %s: %s", rootUID, err)
+		}
+		for _, parent := range root.Parents {
+			titles = append(titles, parent.Title)
+			uids = append(uids, parent.UID)
+		}
+		titles = append(titles, root.Title)
+		uids = append(uids, root.UID)
+	}
+
+	items := []interface{}{}
+	if _, err := appendFolderTreeItems(client, rootUID, titles, uids, 0, &items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "folder_tree:"+rootUID))
+	return diag.FromErr(d.Set("folders", items))
+}
+
+// appendFolderTreeItems appends the subfolders of the given parent to the items, depth-first, and returns their UIDs.
+func appendFolderTreeItems(client *goapi.GrafanaHTTPAPI, parentUID string, titles, uids []string, depth int, items *[]interface{}) ([]string, error) {
+	var childrenUIDs []string
+	var limit int64 = 1000
+	for page := int64(1); ; page++ {
+		params := folders.NewGetFoldersParams().WithLimit(&limit).WithPage(&page)
+		if parentUID != "" {
+			params.SetParentUID(&parentUID)
+		}
+		resp, err := client.Folders.GetFolders(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, folder := range resp.Payload {
+			// Without nested folders, the parent is ignored and all folders are returned
+			if folder.ParentUID != parentUID {
+				continue
+			}
+			childrenUIDs = append(childrenUIDs, folder.UID)
+			folderTitles := append(append([]string{}, titles...), folder.Title)
+			folderUIDs := append(append([]string{}, uids...), folder.UID)
+			item := map[string]interface{}{
+				"uid":               folder.UID,
+				"title":             folder.Title,
+				"parent_folder_uid": parentUID,
+				"depth":             depth,
+				"path":              strings.Join(folderTitles, "/"),
+				"uid_path":          strings.Join(folderUIDs, "/"),
+			}
+			*items = append(*items, item)
+			children, err := appendFolderTreeItems(client, folder.UID, folderTitles, folderUIDs, depth+1, items)
+			if err != nil {
+				return nil, err
+			}
+			item["children_uids"] = children
+		}
+
+		if int64(len(resp.Payload)) < limit {
+			break
+		}
+	}
+	return childrenUIDs, nil
+}
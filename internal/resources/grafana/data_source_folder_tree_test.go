@@ -0,0 +1,49 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceFolderTree_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	var parent, child, grandchild models.Folder
+
+	// TODO: Make parallelizable
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			folderCheckExists.destroyed(&parent, nil),
+			folderCheckExists.destroyed(&child, nil),
+			folderCheckExists.destroyed(&grandchild, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExample(t, "data-sources/grafana_folder_tree/data-source.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.parent", &parent),
+					folderCheckExists.exists("grafana_folder.child", &child),
+					folderCheckExists.exists("grafana_folder.grandchild", &grandchild),
+					resource.TestMatchResourceAttr("data.grafana_folder_tree.from_parent", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.#", "2"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.uid", "tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.parent_folder_uid", "tree-parent"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.path", "Tree Parent/Tree Child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.uid_path", "tree-parent/tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.children_uids.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.0.children_uids.0", "tree-grandchild"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.1.uid", "tree-grandchild"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.1.parent_folder_uid", "tree-child"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.1.depth", "1"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.1.path", "Tree Parent/Tree Child/Tree Grandchild"),
+					resource.TestCheckResourceAttr("data.grafana_folder_tree.from_parent", "folders.1.children_uids.#", "0"),
+				),
+			},
+		},
+	})
+}
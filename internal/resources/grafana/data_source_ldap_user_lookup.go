@@ -0,0 +1,192 @@
+package grafana
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceLDAPUserLookup() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Looks up a user in LDAP, and shows how the user would be mapped in Grafana when synced: their attributes, their organization roles and their teams.
+It can be used to validate the LDAP configuration and the group mappings.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-authentication/ldap/#ldap-debug-view)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/admin/)
+
+This data source uses Grafana's admin APIs which require a Grafana server admin.
+`,
+		ReadContext: dataSourceLDAPUserLookupRead,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username to look up in LDAP.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first name of the user, read from LDAP.",
+			},
+			"surname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The surname of the user, read from LDAP.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email of the user, read from LDAP.",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user, read from LDAP.",
+			},
+			"is_grafana_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user would be a Grafana server admin.",
+			},
+			"is_disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user is disabled in Grafana.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The organization roles the user would have, with the LDAP groups granting them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the organization.",
+						},
+						"org_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the organization.",
+						},
+						"org_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the user in the organization.",
+						},
+						"group_dn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The distinguished name of the LDAP group granting the role.",
+						},
+					},
+				},
+			},
+			"teams": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The teams the user would be a member of, synced from LDAP groups. Available with Grafana Enterprise.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the team.",
+						},
+						"org_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the organization of the team.",
+						},
+						"group_dn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The distinguished name of the LDAP group synced with the team.",
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_ldap_user_lookup", schema)
+}
+
+// ldapAttribute is an attribute of a user looked up in LDAP. The generated client doesn't model the response of the LDAP debug endpoint.
+type ldapAttribute struct {
+	ConfigAttributeValue string   `json:"cfgAttrValue"`
+	LDAPAttributeValue   []string `json:"ldapValue"`
+}
+
+func (a *ldapAttribute) value() string {
+	if a == nil || len(a.LDAPAttributeValue) == 0 {
+		return ""
+	}
+	return a.LDAPAttributeValue[0]
+}
+
+type ldapUser struct {
+	Name           *ldapAttribute `json:"name"`
+	Surname        *ldapAttribute `json:"surname"`
+	Email          *ldapAttribute `json:"email"`
+	Login          *ldapAttribute `json:"login"`
+	IsGrafanaAdmin *bool          `json:"isGrafanaAdmin"`
+	IsDisabled     bool           `json:"isDisabled"`
+	Roles          []struct {
+		OrgID   int64  `json:"orgId"`
+		OrgName string `json:"orgName"`
+		OrgRole string `json:"orgRole"`
+		GroupDN string `json:"groupDN"`
+	} `json:"roles"`
+	Teams []struct {
+		TeamName string `json:"teamName"`
+		OrgName  string `json:"orgName"`
+		GroupDN  string `json:"groupDN"`
+	} `json:"teams"`
+}
+
+func dataSourceLDAPUserLookupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := OAPIGlobalClient(meta) // LDAP is configured for the whole server
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	username := d.Get("username").(string)
+
+	var user ldapUser
+	if err := grafanaAPIRequest(ctx, client, "GET", "/admin/ldap/"+url.PathEscape(username), nil, &user); err != nil {
+		return diag.Errorf("failed to look up user %s in LDAP: %s", username, err)
+	}
+
+	roles := make([]interface{}, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, map[string]interface{}{
+			"org_id":   role.OrgID,
+			"org_name": role.OrgName,
+			"org_role": role.OrgRole,
+			"group_dn": role.GroupDN,
+		})
+	}
+	teams := make([]interface{}, 0, len(user.Teams))
+	for _, team := range user.Teams {
+		teams = append(teams, map[string]interface{}{
+			"team_name": team.TeamName,
+			"org_name":  team.OrgName,
+			"group_dn":  team.GroupDN,
+		})
+	}
+
+	d.SetId(username)
+	d.Set("name", user.Name.value())
+	d.Set("surname", user.Surname.value())
+	d.Set("email", user.Email.value())
+	d.Set("login", user.Login.value())
+	d.Set("is_grafana_admin", user.IsGrafanaAdmin != nil && *user.IsGrafanaAdmin)
+	d.Set("is_disabled", user.IsDisabled)
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("teams", teams))
+}
@@ -0,0 +1,59 @@
+package grafana_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceLDAPUserLookup_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.3")
+	// Started with `make testacc-ldap-docker`
+	if os.Getenv("GRAFANA_LDAP_HOST") == "" {
+		t.Skip("GRAFANA_LDAP_HOST must be set to the host of the test OpenLDAP server")
+	}
+
+	api := grafanaTestClient()
+	defaultSettings, err := api.SsoSettings.GetProviderSettings("ldap")
+	if err != nil {
+		t.Fatalf("failed to fetch the default settings for provider ldap: %v", err)
+	}
+
+	config := testutils.TestAccExampleWithReplace(t, "resources/grafana_ldap_group_mapping/resource.tf", map[string]string{
+		`"openldap"`: `"` + os.Getenv("GRAFANA_LDAP_HOST") + `"`,
+	}) + `
+data "grafana_ldap_user_lookup" "editor" {
+  username   = "ldap-editor"
+  depends_on = [grafana_ldap_group_mapping.editors]
+}
+
+data "grafana_ldap_user_lookup" "admin" {
+  username   = "ldap-admin"
+  depends_on = [grafana_ldap_group_mapping.admins]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             checkSsoSettingsReset(api, "ldap", defaultSettings.Payload),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "login", "ldap-editor"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "email", "ldap-editor@grafana.org"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "surname", "Editor"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "is_grafana_admin", "false"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "roles.0.org_id", "1"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "roles.0.org_role", "Editor"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.editor", "roles.0.group_dn", "cn=editors,ou=groups,dc=grafana,dc=org"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.admin", "is_grafana_admin", "true"),
+					resource.TestCheckResourceAttr("data.grafana_ldap_user_lookup.admin", "roles.0.org_role", "Admin"),
+				),
+			},
+		},
+	})
+}
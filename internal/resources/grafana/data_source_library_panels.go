@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"slices"
 
 	"github.com/grafana/grafana-openapi-client-go/client/library_elements"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
@@ -38,6 +39,14 @@ func (r *libraryPanelsDataSource) Schema(ctx context.Context, req datasource.Sch
 				Computed: true,
 			},
 			"org_id": pluginFrameworkOrgIDAttribute(),
+			"folder_uid": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only the library panels in this folder are returned.",
+			},
+			"dashboard_uid": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, only the library panels connected to this dashboard are returned.",
+			},
 			"panels": schema.SetAttribute{
 				Computed: true,
 				ElementType: types.ObjectType{
@@ -63,9 +72,11 @@ type libraryPanelsDataSourcePanelModel struct {
 }
 
 type libraryPanelsDataSourceModel struct {
-	ID     types.String                        `tfsdk:"id"`
-	OrgID  types.String                        `tfsdk:"org_id"`
-	Panels []libraryPanelsDataSourcePanelModel `tfsdk:"panels"`
+	ID           types.String                        `tfsdk:"id"`
+	OrgID        types.String                        `tfsdk:"org_id"`
+	FolderUID    types.String                        `tfsdk:"folder_uid"`
+	DashboardUID types.String                        `tfsdk:"dashboard_uid"`
+	Panels       []libraryPanelsDataSourcePanelModel `tfsdk:"panels"`
 }
 
 func (r *libraryPanelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -86,6 +97,20 @@ func (r *libraryPanelsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 	for _, panel := range apiResp.Payload.Result.Elements {
+		if folderUID := data.FolderUID.ValueString(); folderUID != "" && panel.Meta.FolderUID != folderUID {
+			continue
+		}
+		if dashboardUID := data.DashboardUID.ValueString(); dashboardUID != "" {
+			_, dashboardUIDs, err := getLibraryPanelConnections(client, panel.UID)
+			if err != nil {
+				resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get library panel connections", err.Error())}
+				return
+			}
+			if !slices.Contains(dashboardUIDs, dashboardUID) {
+				continue
+			}
+		}
+
 		modelJSONBytes, err := json.Marshal(panel.Model)
 		if err != nil {
 			resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get library panel JSON", err.Error())}
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
@@ -31,6 +32,43 @@ func TestAccDatasourceLibraryPanels_basic(t *testing.T) {
 						"folder_uid":    randomName + "-folder",
 						"panels.0.name": randomName + " In Folder",
 					}),
+					resource.TestCheckResourceAttr("data.grafana_library_panels.in_folder", "panels.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_library_panels.in_folder", "panels.*", map[string]string{
+						"name":       randomName + " In Folder",
+						"folder_uid": randomName + "-folder",
+					}),
+				),
+			},
+			{
+				// Library panels are connected to the dashboards that use them
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_library_panels/data-source.tf", map[string]string{
+					"panelname": randomName,
+				}) + fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+  config_json = jsonencode({
+    uid   = "%[1]s-dashboard"
+    title = "%[1]s Dashboard"
+    panels = [{
+      id      = 1
+      gridPos = { x = 0, y = 0, h = 10, w = 10 }
+      libraryPanel = {
+        uid  = grafana_library_panel.folder.uid
+        name = grafana_library_panel.folder.name
+      }
+    }]
+  })
+}
+
+data "grafana_library_panels" "in_dashboard" {
+  dashboard_uid = grafana_dashboard.test.uid
+}
+`, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafana_library_panels.in_dashboard", "panels.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_library_panels.in_dashboard", "panels.*", map[string]string{
+						"name":       randomName + " In Folder",
+						"folder_uid": randomName + "-folder",
+					}),
 				),
 			},
 		},
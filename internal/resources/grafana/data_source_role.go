@@ -25,6 +25,8 @@ func datasourceRole() *common.DataSource {
 				Description: "Name of the role",
 			},
 			"auto_increment_version": nil,
+			"manage_permissions":     nil,
+			"validate_permissions":   nil,
 		}),
 	}
 	return common.NewLegacySDKDataSource(common.CategoryGrafanaEnterprise, "grafana_role", schema)
@@ -0,0 +1,193 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceServiceAccounts() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the service accounts of an organization, with the metadata of their tokens. It can be used to find the tokens which are about to expire or which aren't used anymore.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/service-accounts/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/#search-service-accounts-with-paging)
+`,
+		ReadContext: datasourceServiceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the service accounts with this basic role. Can be `Viewer`, `Editor`, `Admin` or `None`.",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set, only list the disabled service accounts (`true`) or the enabled ones (`false`).",
+			},
+			"service_accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The service accounts matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service account, as used by the `grafana_service_account` resource.",
+						},
+						"service_account_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The numerical ID of the service account.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service account.",
+						},
+						"login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the service account.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The basic role of the service account in the organization.",
+						},
+						"is_disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the service account is disabled.",
+						},
+						"tokens": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tokens of the service account.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The numerical ID of the token.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the token.",
+									},
+									"created_at": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The creation time of the token, in RFC3339 format.",
+									},
+									"expiration": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The expiration time of the token, in RFC3339 format. Empty if the token doesn't expire.",
+									},
+									"has_expired": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the token has expired.",
+									},
+									"last_used_at": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The last time the token was used, in RFC3339 format. Empty if the token was never used.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_service_accounts", schema)
+}
+
+func datasourceServiceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	role := d.Get("role").(string)
+
+	params := service_accounts.NewSearchOrgServiceAccountsWithPagingParams()
+	if disabled := d.GetRawConfig().GetAttr("disabled"); !disabled.IsNull() {
+		isDisabled := disabled.True()
+		params.SetDisabled(&isDisabled)
+	}
+
+	var serviceAccounts []*models.ServiceAccountDTO
+	var page int64 = 1
+	for {
+		resp, err := client.ServiceAccounts.SearchOrgServiceAccountsWithPaging(params.WithPage(&page))
+		if err != nil {
+			return diag.Errorf("failed to search service accounts: %s", err)
+		}
+		serviceAccounts = append(serviceAccounts, resp.Payload.ServiceAccounts...)
+		if len(resp.Payload.ServiceAccounts) == 0 || resp.Payload.TotalCount <= int64(len(serviceAccounts)) {
+			break
+		}
+		page++
+	}
+
+	items := make([]interface{}, 0, len(serviceAccounts))
+	for _, sa := range serviceAccounts {
+		// The role isn't a search filter of the API
+		if role != "" && sa.Role != role {
+			continue
+		}
+
+		tokens := make([]interface{}, 0, sa.Tokens)
+		if sa.Tokens > 0 {
+			resp, err := client.ServiceAccounts.ListTokens(sa.ID)
+			if err != nil {
+				return diag.Errorf("failed to list the tokens of service account %d: %s", sa.ID, err)
+			}
+			for _, token := range resp.Payload {
+				tokens = append(tokens, map[string]interface{}{
+					"id":           token.ID,
+					"name":         token.Name,
+					"created_at":   formatServiceAccountTokenTime(token.Created),
+					"expiration":   formatServiceAccountTokenTime(token.Expiration),
+					"has_expired":  token.HasExpired,
+					"last_used_at": formatServiceAccountTokenTime(token.LastUsedAt),
+				})
+			}
+		}
+
+		items = append(items, map[string]interface{}{
+			"id":                 MakeOrgResourceID(orgID, sa.ID),
+			"service_account_id": sa.ID,
+			"name":               sa.Name,
+			"login":              sa.Login,
+			"role":               sa.Role,
+			"is_disabled":        sa.IsDisabled,
+			"tokens":             tokens,
+		})
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, "service_accounts"))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	return diag.FromErr(d.Set("service_accounts", items))
+}
+
+// formatServiceAccountTokenTime formats the times of the tokens, unset times are empty.
+func formatServiceAccountTokenTime(t strfmt.DateTime) string {
+	if t.IsZero() || time.Time(t).Unix() <= 0 {
+		return ""
+	}
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,73 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceServiceAccounts_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	var sa models.ServiceAccountDTO
+	name := acctest.RandString(10)
+
+	config := testutils.TestAccExampleWithReplace(t, "data-sources/grafana_service_accounts/data-source.tf", map[string]string{
+		`"ci"`:       `"` + name + `"`,
+		`"ci-token"`: `"` + name + `-token"`,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             serviceAccountCheckExists.destroyed(&sa, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					serviceAccountCheckExists.exists("grafana_service_account.ci", &sa),
+					resource.TestMatchResourceAttr("data.grafana_service_accounts.editors", "id", defaultOrgIDRegexp),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_service_accounts.editors", "service_accounts.*", map[string]string{
+						"name":                  name,
+						"role":                  "Editor",
+						"is_disabled":           "false",
+						"tokens.#":              "1",
+						"tokens.0.name":         name + "-token",
+						"tokens.0.has_expired":  "false",
+						"tokens.0.last_used_at": "",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.grafana_service_accounts.editors", "service_accounts.*.id", "grafana_service_account.ci", "id"),
+				),
+			},
+			// The filters exclude the service account
+			{
+				Config: strings.Replace(config, `role     = "Editor"`, `role     = "Viewer"`, 1),
+				Check:  testAccServiceAccountsCheckMissing("data.grafana_service_accounts.editors", name),
+			},
+			{
+				Config: strings.Replace(config, `disabled = false`, `disabled = true`, 1),
+				Check:  testAccServiceAccountsCheckMissing("data.grafana_service_accounts.editors", name),
+			},
+		},
+	})
+}
+
+func testAccServiceAccountsCheckMissing(dataSourceName, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", dataSourceName)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasSuffix(key, ".name") && value == name {
+				return fmt.Errorf("expected service account %s to be filtered out, found it at %s", name, key)
+			}
+		}
+		return nil
+	}
+}
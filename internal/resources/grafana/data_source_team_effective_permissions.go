@@ -0,0 +1,179 @@
+package grafana
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceTeamEffectivePermissions() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the permissions granted to a team by the roles assigned to it, including the fixed, plugin and custom roles.
+
+**Note:** This data source is available only with Grafana Enterprise 9.0+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/#list-roles-assigned-to-a-team)
+`,
+		ReadContext: dataSourceTeamEffectivePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the team.",
+			},
+			"action_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the permissions whose action starts with this prefix (for example: `dashboards:`).",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The roles assigned to the team.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The UID of the role.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the role.",
+						},
+						"global": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the role is available across all organizations.",
+						},
+					},
+				},
+			},
+			"actions":     effectivePermissionsActionsSchema(),
+			"permissions": effectivePermissionsSchema(),
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaEnterprise, "grafana_team_effective_permissions", schema)
+}
+
+func dataSourceTeamEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	_, teamIDStr := SplitOrgResourceID(d.Get("team_id").(string))
+	teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid team ID %s: %s", teamIDStr, err)
+	}
+
+	resp, err := client.AccessControl.ListTeamsRoles(&models.RolesSearchQuery{
+		OrgID:         orgID,
+		TeamIds:       []int64{teamID},
+		IncludeHidden: true,
+	})
+	if err != nil {
+		return diag.Errorf("failed to search the roles of team %d: %s", teamID, err)
+	}
+
+	roles := make([]interface{}, 0)
+	scopesByAction := map[string][]string{}
+	for _, role := range resp.Payload[teamIDStr] {
+		roles = append(roles, map[string]interface{}{
+			"uid":    role.UID,
+			"name":   role.Name,
+			"global": role.Global,
+		})
+
+		// The search doesn't return the permissions of the roles with all versions of Grafana
+		permissions := role.Permissions
+		if len(permissions) == 0 {
+			roleResp, err := client.AccessControl.GetRole(role.UID)
+			if err != nil {
+				return diag.Errorf("failed to get role %s: %s", role.UID, err)
+			}
+			permissions = roleResp.Payload.Permissions
+		}
+		for _, permission := range permissions {
+			scopesByAction[permission.Action] = append(scopesByAction[permission.Action], permission.Scope)
+		}
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, teamID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+	return setEffectivePermissions(d, scopesByAction)
+}
+
+func effectivePermissionsActionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "The actions which are granted, whatever their scope.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func effectivePermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The permissions which are granted, sorted by action and scope.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The action which is granted (for example: `dashboards:read`).",
+				},
+				"scope": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The resources on which the action is granted (for example: `dashboards:uid:abc`). Empty for actions which don't apply to resources.",
+				},
+			},
+		},
+	}
+}
+
+// setEffectivePermissions sets the `actions` and `permissions` attributes from the scopes granted for each action, deduplicated and sorted.
+func setEffectivePermissions(d *schema.ResourceData, scopesByAction map[string][]string) diag.Diagnostics {
+	actionPrefix := d.Get("action_prefix").(string)
+
+	actions := make([]string, 0, len(scopesByAction))
+	for action := range scopesByAction {
+		if strings.HasPrefix(action, actionPrefix) {
+			actions = append(actions, action)
+		}
+	}
+	sort.Strings(actions)
+
+	permissions := make([]interface{}, 0)
+	for _, action := range actions {
+		scopes := scopesByAction[action]
+		sort.Strings(scopes)
+		for i, scope := range scopes {
+			if i > 0 && scopes[i-1] == scope {
+				continue
+			}
+			permissions = append(permissions, map[string]interface{}{
+				"action": action,
+				"scope":  scope,
+			})
+		}
+	}
+
+	if err := d.Set("actions", actions); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("permissions", permissions))
+}
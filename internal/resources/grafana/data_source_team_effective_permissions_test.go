@@ -0,0 +1,45 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceTeamEffectivePermissions_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	var team models.TeamDTO
+	var role models.RoleDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			teamCheckExists.destroyed(&team, nil),
+			roleCheckExists.destroyed(&role, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_team_effective_permissions/data-source.tf", map[string]string{
+					`"SRE"`:            `"` + name + `"`,
+					`"folders-reader"`: `"` + name + `"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.sre", &team),
+					roleCheckExists.exists("grafana_role.folders_reader", &role),
+					resource.TestCheckResourceAttrPair("data.grafana_team_effective_permissions.sre", "id", "grafana_team.sre", "id"),
+					resource.TestCheckResourceAttr("data.grafana_team_effective_permissions.sre", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_team_effective_permissions.sre", "roles.0.uid", name),
+					resource.TestCheckTypeSetElemAttr("data.grafana_team_effective_permissions.sre", "actions.*", "folders:read"),
+					resource.TestCheckResourceAttr("data.grafana_team_effective_permissions.sre", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.grafana_team_effective_permissions.sre", "permissions.0.action", "folders:read"),
+					resource.TestCheckResourceAttr("data.grafana_team_effective_permissions.sre", "permissions.0.scope", "folders:*"),
+				),
+			},
+		},
+	})
+}
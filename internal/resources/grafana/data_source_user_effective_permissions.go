@@ -0,0 +1,64 @@
+package grafana
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceUserEffectivePermissions() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the permissions of a user in an organization. They include the permissions granted by the basic role of the user, by the roles assigned to the user and to their teams, and by the permissions of dashboards, folders and other resources.
+
+**Note:** This data source is available only with Grafana 10.0+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
+`,
+		ReadContext: dataSourceUserEffectivePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"user_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The numerical ID of the user.",
+			},
+			"action_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the permissions whose action starts with this prefix (for example: `dashboards:`).",
+			},
+			"actions":     effectivePermissionsActionsSchema(),
+			"permissions": effectivePermissionsSchema(),
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_user_effective_permissions", schema)
+}
+
+func dataSourceUserEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	userID := int64(d.Get("user_id").(int))
+
+	query := url.Values{}
+	query.Set("userId", strconv.FormatInt(userID, 10))
+	if actionPrefix := d.Get("action_prefix").(string); actionPrefix != "" {
+		query.Set("actionPrefix", actionPrefix)
+	}
+
+	// The search returns the scopes granted for each action, by user ID
+	var result map[string]map[string][]string
+	if err := grafanaAPIRequest(ctx, client, "GET", "/access-control/users/permissions/search?"+query.Encode(), nil, &result); err != nil {
+		return diag.Errorf("failed to search the permissions of user %d: %s", userID, err)
+	}
+	// Users without any permission in the org, such as non-members, are missing from the result
+	scopesByAction := result[strconv.FormatInt(userID, 10)]
+
+	d.SetId(MakeOrgResourceID(orgID, userID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	return setEffectivePermissions(d, scopesByAction)
+}
@@ -0,0 +1,37 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceUserEffectivePermissions_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var user models.UserProfileDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             userCheckExists.destroyed(&user, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_user_effective_permissions/data-source.tf", map[string]string{
+					"alice@example.com": name + "@example.com",
+					`"alice"`:           `"` + name + `"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					userCheckExists.exists("grafana_user.alice", &user),
+					resource.TestMatchResourceAttr("data.grafana_user_effective_permissions.alice", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttrPair("data.grafana_user_effective_permissions.alice", "user_id", "grafana_user.alice", "user_id"),
+					// Users are added to the default org as viewers
+					resource.TestCheckOutput("alice_can_create_dashboards", "false"),
+				),
+			},
+		},
+	})
+}
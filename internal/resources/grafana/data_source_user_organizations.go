@@ -0,0 +1,98 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceUserOrganizations() *common.DataSource {
+	schema := &schema.Resource{
+		Description: `
+Lists the organizations a user is a member of, with the user's role in each of them.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/user-management/server-user-management/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/user/#get-organizations-for-user)
+
+This data source uses Grafana's admin APIs for reading users which
+does not currently work with API Tokens. You must use basic auth.
+This data source is also not compatible with Grafana Cloud, as it does not allow basic auth.
+`,
+		ReadContext: dataSourceUserOrganizationsRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The numerical ID of the Grafana user.",
+				ExactlyOneOf: []string{"user_id", "login_or_email"},
+			},
+			"login_or_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The login or email of the Grafana user.",
+			},
+			"organizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The organizations of the user.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the organization.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the organization.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The role of the user in the organization.",
+						},
+					},
+				},
+			},
+		},
+	}
+	return common.NewLegacySDKDataSource(common.CategoryGrafanaOSS, "grafana_user_organizations", schema)
+}
+
+func dataSourceUserOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := OAPIGlobalClient(meta) // Users are global/org-agnostic
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userID := int64(d.Get("user_id").(int))
+	if loginOrEmail := d.Get("login_or_email").(string); loginOrEmail != "" {
+		resp, err := client.Users.GetUserByLoginOrEmail(loginOrEmail)
+		if err != nil {
+			return diag.Errorf("failed to get user %s: %s", loginOrEmail, err)
+		}
+		userID = resp.Payload.ID
+	}
+
+	resp, err := client.Users.GetUserOrgList(userID)
+	if err != nil {
+		return diag.Errorf("failed to list the organizations of user %d: %s", userID, err)
+	}
+
+	organizations := make([]interface{}, 0, len(resp.Payload))
+	for _, org := range resp.Payload {
+		organizations = append(organizations, map[string]interface{}{
+			"org_id": org.OrgID,
+			"name":   org.Name,
+			"role":   org.Role,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", userID))
+	d.Set("user_id", userID)
+	return diag.FromErr(d.Set("organizations", organizations))
+}
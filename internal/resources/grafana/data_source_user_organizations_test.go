@@ -0,0 +1,44 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceUserOrganizations_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var org models.OrgDetailsDTO
+	var user models.UserProfileDTO
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			orgCheckExists.destroyed(&org, nil),
+			userCheckExists.destroyed(&user, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "data-sources/grafana_user_organizations/data-source.tf", map[string]string{
+					`"Team"`:            `"` + name + `"`,
+					"alice@example.com": name + "@example.com",
+					`"alice"`:           `"` + name + `"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization.team", &org),
+					userCheckExists.exists("grafana_user.alice", &user),
+					resource.TestCheckResourceAttrPair("data.grafana_user_organizations.alice", "user_id", "grafana_user.alice", "user_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafana_user_organizations.alice", "organizations.*", map[string]string{
+						"name": name,
+						"role": "Editor",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 )
@@ -46,6 +47,7 @@ var (
 )
 
 func resourceContactPoint() *common.Resource {
+	testFailureSeverities := []string{"warning", "error"}
 	resource := &schema.Resource{
 		Description: `
 Manages Grafana Alerting contact points.
@@ -53,12 +55,15 @@ Manages Grafana Alerting contact points.
 * [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#contact-points)
 
+Contact points created in the UI or provisioned from files can be imported. Their provenance is then changed to match ` + "`disable_provenance`" + ` on the next apply.
+
 This resource requires Grafana 9.1.0 or later.
 `,
 		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](updateContactPoint),
 		ReadContext:   readContactPoint,
 		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](updateContactPoint),
 		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteContactPoint),
+		CustomizeDiff: provenanceCustomizeDiff(relaxedProvenanceTransition),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -77,8 +82,21 @@ This resource requires Grafana 9.1.0 or later.
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				ForceNew:    true, // Can't modify provenance on contact points
-				Description: "Allow modifying the contact point from other sources than Terraform or the Grafana API.",
+				Description: "Allow modifying the contact point from other sources than Terraform or the Grafana API. Provenance can be enabled in-place (ex: to adopt a contact point created in the UI or provisioned from files), but disabling it recreates the contact point.",
+			},
+			"provenance": provenanceAttribute(),
+			"test_on_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a test notification through each integration of the contact point after it is created or updated. Failed integrations are reported as diagnostics, with the severity set in `test_failure_severity`.",
+			},
+			"test_failure_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "error",
+				ValidateFunc: validation.StringInSlice(testFailureSeverities, false),
+				Description:  common.AllowedValuesDescription("The severity of the diagnostics reported when a test notification fails. When set to `error`, the apply fails", testFailureSeverities),
 			},
 		},
 	}
@@ -139,16 +157,10 @@ func listContactPoints(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID
 func readContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
 
-	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
+	points, err := getContactPointsByName(client, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	var points []*models.EmbeddedContactPoint
-	for _, p := range resp.Payload {
-		if p.Name == name {
-			points = append(points, p)
-		}
-	}
 	if len(points) == 0 {
 		return common.WarnMissing("contact point", data)
 	}
@@ -157,12 +169,24 @@ func readContactPoint(ctx context.Context, data *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.Set("disable_provenance", data.Get("provenance").(string) == provenanceNone)
 	data.SetId(MakeOrgResourceID(orgID, points[0].Name))
 
+	// The test settings are not stored in Grafana. Set the defaults when importing to avoid a diff on the next plan.
+	if _, ok := data.GetOk("test_failure_severity"); !ok {
+		data.Set("test_on_apply", false)
+		data.Set("test_failure_severity", "error")
+	}
+
 	return nil
 }
 
 func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The test settings are not stored in Grafana, so the integrations aren't sent again when only these change
+	if !data.IsNewResource() && !data.HasChangesExcept("test_on_apply", "test_failure_severity") {
+		return readContactPoint(ctx, data, meta)
+	}
+
 	client, orgID := OAPIClientFromNewOrgResource(meta, data)
 
 	ps := unpackContactPoints(data)
@@ -227,7 +251,12 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 	}
 
 	data.SetId(MakeOrgResourceID(orgID, data.Get("name").(string)))
-	return readContactPoint(ctx, data, meta)
+	diags := readContactPoint(ctx, data, meta)
+	if diags.HasError() || !data.Get("test_on_apply").(bool) {
+		return diags
+	}
+
+	return append(diags, testContactPoint(ctx, client, data, ps)...)
 }
 
 func deleteContactPoint(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -247,6 +276,97 @@ func deleteContactPoint(ctx context.Context, data *schema.ResourceData, meta int
 	return nil
 }
 
+// testContactPoint sends a test notification through each integration of the contact point.
+// Failures are returned as diagnostics with the severity configured in `test_failure_severity`.
+func testContactPoint(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *schema.ResourceData, ps []statePair) diag.Diagnostics {
+	severity := diag.Error
+	if data.Get("test_failure_severity").(string) == "warning" {
+		severity = diag.Warning
+	}
+
+	type testIntegration struct {
+		UID                   string      `json:"uid"`
+		Name                  string      `json:"name"`
+		Type                  string      `json:"type"`
+		DisableResolveMessage bool        `json:"disableResolveMessage"`
+		Settings              interface{} `json:"settings"`
+	}
+	type testReceiver struct {
+		Name         string            `json:"name"`
+		Integrations []testIntegration `json:"grafana_managed_receiver_configs"`
+	}
+	type testResult struct {
+		Receivers []struct {
+			Name         string `json:"name"`
+			Integrations []struct {
+				UID    string `json:"uid"`
+				Name   string `json:"name"`
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			} `json:"grafana_managed_receiver_configs"`
+		} `json:"receivers"`
+	}
+
+	name := data.Get("name").(string)
+	receiver := testReceiver{Name: name}
+	integrationTypes := map[string]string{}
+	for _, p := range ps {
+		if p.deleted {
+			continue
+		}
+		uid := p.tfState["uid"].(string)
+		receiver.Integrations = append(receiver.Integrations, testIntegration{
+			UID:                   uid,
+			Name:                  name,
+			Type:                  *p.gfState.Type,
+			DisableResolveMessage: p.gfState.DisableResolveMessage,
+			Settings:              p.gfState.Settings,
+		})
+		integrationTypes[uid] = *p.gfState.Type
+	}
+
+	// The API returns a 207 status when some of the integrations failed, the details are in the body.
+	var result testResult
+	body := map[string]interface{}{"receivers": []testReceiver{receiver}}
+	if err := grafanaAPIRequest(ctx, client, "POST", "/alertmanager/grafana/config/api/v1/receivers/test", body, &result); err != nil {
+		return diag.Diagnostics{{
+			Severity: severity,
+			Summary:  fmt.Sprintf("failed to test contact point %q", name),
+			Detail:   err.Error(),
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, r := range result.Receivers {
+		for _, i := range r.Integrations {
+			if i.Status == "ok" {
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("test notification failed for the %s integration (UID %q) of contact point %q", integrationTypes[i.UID], i.UID, name),
+				Detail:   i.Error,
+			})
+		}
+	}
+	return diags
+}
+
+// getContactPointsByName returns the notifiers of the contact point with the given name.
+func getContactPointsByName(client *goapi.GrafanaHTTPAPI, name string) ([]*models.EmbeddedContactPoint, error) {
+	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
+	if err != nil {
+		return nil, err
+	}
+	var points []*models.EmbeddedContactPoint
+	for _, p := range resp.Payload {
+		if p.Name == name {
+			points = append(points, p)
+		}
+	}
+	return points, nil
+}
+
 // unpackContactPoints unpacks the contact points from the Terraform state.
 // It returns a slice of statePairs, which contain the Terraform state and the Grafana state for each contact point.
 // It also tracks receivers that should be deleted. There are two cases where a receiver should be deleted:
@@ -318,11 +438,13 @@ func unpackPointConfig(n notifier, data interface{}, name string) *models.Embedd
 
 func packContactPoints(ps []*models.EmbeddedContactPoint, data *schema.ResourceData) error {
 	pointsPerNotifier := map[notifier][]interface{}{}
-	disableProvenance := true
+	provenance := provenanceNone
 	for _, p := range ps {
-		data.Set("name", p.Name)
-		if p.Provenance != "" {
-			disableProvenance = false
+		if err := data.Set("name", p.Name); err != nil {
+			return err
+		}
+		if p.Provenance != provenanceNone {
+			provenance = p.Provenance
 		}
 
 		for _, n := range notifiers {
@@ -336,10 +458,14 @@ func packContactPoints(ps []*models.EmbeddedContactPoint, data *schema.ResourceD
 			}
 		}
 	}
-	data.Set("disable_provenance", disableProvenance)
+	if err := data.Set("provenance", provenance); err != nil {
+		return err
+	}
 
 	for n, pts := range pointsPerNotifier {
-		data.Set(n.meta().field, pts)
+		if err := data.Set(n.meta().field, pts); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -568,6 +568,7 @@ func TestAccContactPoint_disableProvenance(t *testing.T) {
 					checkAlertingContactPointExistsWithLength("grafana_contact_point.my_contact_point", &points, 1),
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "name", name),
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "disable_provenance", "false"),
+					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "provenance", "api"),
 				),
 			},
 			// Import (tests that disable_provenance is fetched from API)
@@ -583,6 +584,7 @@ func TestAccContactPoint_disableProvenance(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "name", name),
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "disable_provenance", "true"),
+					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "provenance", ""),
 				),
 			},
 			// Import (tests that disable_provenance is fetched from API)
@@ -597,6 +599,7 @@ func TestAccContactPoint_disableProvenance(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "name", name),
 					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "disable_provenance", "false"),
+					resource.TestCheckResourceAttr("grafana_contact_point.my_contact_point", "provenance", "api"),
 				),
 			},
 		},
@@ -686,3 +689,59 @@ func testAccContactPointWithSensitiveData(name, url, apiKey string) string {
 		  }
 	}`, name, url, apiKey)
 }
+
+func TestAccContactPoint_testOnApply(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	var points models.ContactPoints
+	name := acctest.RandString(10)
+
+	config := func(url, severity string) string {
+		return fmt.Sprintf(`
+		resource "grafana_contact_point" "test" {
+			name                  = "%s"
+			test_on_apply         = true
+			test_failure_severity = "%s"
+
+			webhook {
+				url = "%s"
+			}
+		}`, name, severity, url)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             alertingContactPointCheckExists.destroyed(&points, nil),
+		Steps: []resource.TestStep{
+			// A failing integration returns an error
+			{
+				Config:      config("http://localhost:1/unreachable", "error"),
+				ExpectError: regexp.MustCompile(`test notification failed for the webhook integration`),
+			},
+			// With the warning severity, the apply succeeds
+			{
+				Config: config("http://localhost:1/unreachable", "warning"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingContactPointCheckExists.exists("grafana_contact_point.test", &points),
+					resource.TestCheckResourceAttr("grafana_contact_point.test", "test_on_apply", "true"),
+					resource.TestCheckResourceAttr("grafana_contact_point.test", "test_failure_severity", "warning"),
+				),
+			},
+			// Changing only the test settings doesn't update the integrations, so no test notification is sent
+			{
+				Config: config("http://localhost:1/unreachable", "error"),
+				Check: resource.ComposeTestCheckFunc(
+					alertingContactPointCheckExists.exists("grafana_contact_point.test", &points),
+					resource.TestCheckResourceAttr("grafana_contact_point.test", "test_failure_severity", "error"),
+				),
+			},
+			// Test import. The test settings are set to their defaults
+			{
+				ResourceName:            "grafana_contact_point.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_on_apply", "test_failure_severity"},
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,8 @@ Manages Grafana Alerting mute timings.
 * [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_provisioning/#mute-timings)
 
+Mute timings created in the UI or provisioned from files can be imported. Their provenance is then changed to match ` + "`disable_provenance`" + ` on the next apply.
+
 This resource requires Grafana 9.1.0 or later.
 `,
 
@@ -32,6 +35,7 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readMuteTiming,
 		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](updateMuteTiming),
 		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteMuteTiming),
+		CustomizeDiff: provenanceCustomizeDiff(relaxedProvenanceTransition),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -49,9 +53,9 @@ This resource requires Grafana 9.1.0 or later.
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				ForceNew:    true, // TODO: The API doesn't return provenance, so we have to force new for now.
-				Description: "Allow modifying the mute timing from other sources than Terraform or the Grafana API.",
+				Description: "Allow modifying the mute timing from other sources than Terraform or the Grafana API. Provenance can be enabled in-place (ex: to adopt a mute timing created in the UI or provisioned from files), but disabling it recreates the mute timing.",
 			},
+			"provenance": provenanceAttribute(),
 
 			"intervals": {
 				// List instead of set is necessary here. We rely on diff-suppression on the `months` field.
@@ -163,17 +167,41 @@ func listMuteTimings(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID in
 func readMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
 
-	resp, err := client.Provisioning.GetMuteTiming(name)
+	mt, err := getMuteTiming(ctx, client, name)
 	if err, shouldReturn := common.CheckReadError("mute timing", data, err); shouldReturn {
 		return err
 	}
-	mt := resp.Payload
 
 	data.SetId(MakeOrgResourceID(orgID, mt.Name))
 	data.Set("org_id", strconv.FormatInt(orgID, 10))
-	data.Set("name", mt.Name)
-	data.Set("intervals", packIntervals(mt.TimeIntervals))
-	return nil
+	data.Set("disable_provenance", mt.Provenance == provenanceNone)
+	return diag.FromErr(packMuteTiming(mt, data))
+}
+
+// muteTimingWithProvenance is a mute timing with its provenance, which isn't part of the OpenAPI model.
+type muteTimingWithProvenance struct {
+	models.MuteTimeInterval
+	Provenance string `json:"provenance"`
+}
+
+// getMuteTiming fetches a mute timing directly, to get its provenance.
+func getMuteTiming(ctx context.Context, client *goapi.GrafanaHTTPAPI, name string) (*muteTimingWithProvenance, error) {
+	var mt muteTimingWithProvenance
+	if err := grafanaAPIRequest(ctx, client, "GET", "/v1/provisioning/mute-timings/"+url.PathEscape(name), nil, &mt); err != nil {
+		return nil, err
+	}
+	return &mt, nil
+}
+
+// packMuteTiming sets the attributes shared by the mute timing resource and data source.
+func packMuteTiming(mt *muteTimingWithProvenance, data *schema.ResourceData) error {
+	if err := data.Set("name", mt.Name); err != nil {
+		return err
+	}
+	if err := data.Set("intervals", packIntervals(mt.TimeIntervals)); err != nil {
+		return err
+	}
+	return data.Set("provenance", mt.Provenance)
 }
 
 func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -38,15 +38,15 @@ func TestAccMuteTiming_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_mute_timing.my_mute_timing", "intervals.0.years.0", "2030"),
 					resource.TestCheckResourceAttr("grafana_mute_timing.my_mute_timing", "intervals.0.years.1", "2025:2026"),
 					resource.TestCheckResourceAttr("grafana_mute_timing.my_mute_timing", "intervals.0.location", "America/New_York"),
+					resource.TestCheckResourceAttr("grafana_mute_timing.my_mute_timing", "provenance", "api"),
 					testutils.CheckLister("grafana_mute_timing.my_mute_timing"),
 				),
 			},
 			// Test import.
 			{
-				ResourceName:            "grafana_mute_timing.my_mute_timing",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"disable_provenance"},
+				ResourceName:      "grafana_mute_timing.my_mute_timing",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 			// Test plan (should be empty)
 			{
@@ -222,9 +222,12 @@ func readNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	packNotifPolicy(resp.Payload, data)
+	if err := packNotifPolicy(resp.Payload, data); err != nil {
+		return diag.FromErr(err)
+	}
 	data.SetId(MakeOrgResourceID(orgID, PolicySingletonID))
 	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.Set("disable_provenance", resp.Payload.Provenance == "")
 	return nil
 }
 
@@ -272,21 +275,28 @@ func deleteNotificationPolicy(ctx context.Context, data *schema.ResourceData, me
 	return diag.Diagnostics{}
 }
 
-func packNotifPolicy(npt *models.Route, data *schema.ResourceData) {
-	data.Set("disable_provenance", npt.Provenance == "")
-	data.Set("contact_point", npt.Receiver)
-	data.Set("group_by", npt.GroupBy)
-	data.Set("group_wait", npt.GroupWait)
-	data.Set("group_interval", npt.GroupInterval)
-	data.Set("repeat_interval", npt.RepeatInterval)
+// packNotifPolicy sets the attributes shared by the notification policy resource and data source.
+func packNotifPolicy(npt *models.Route, data *schema.ResourceData) error {
+	for key, value := range map[string]interface{}{
+		"contact_point":   npt.Receiver,
+		"group_by":        npt.GroupBy,
+		"group_wait":      npt.GroupWait,
+		"group_interval":  npt.GroupInterval,
+		"repeat_interval": npt.RepeatInterval,
+	} {
+		if err := data.Set(key, value); err != nil {
+			return err
+		}
+	}
 
 	if len(npt.Routes) > 0 {
 		policies := make([]interface{}, 0, len(npt.Routes))
 		for _, r := range npt.Routes {
 			policies = append(policies, packSpecificPolicy(r, supportedPolicyTreeDepth))
 		}
-		data.Set("policy", policies)
+		return data.Set("policy", policies)
 	}
+	return nil
 }
 
 func packSpecificPolicy(p *models.Route, depth uint) interface{} {
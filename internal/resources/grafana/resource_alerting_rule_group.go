@@ -43,6 +43,7 @@ This resource requires Grafana 9.1.0 or later.
 		ReadContext:   readAlertRuleGroup,
 		UpdateContext: putAlertRuleGroup,
 		DeleteContext: deleteAlertRuleGroup,
+		CustomizeDiff: provenanceCustomizeDiff(ruleGroupProvenanceTransition),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -72,8 +73,9 @@ This resource requires Grafana 9.1.0 or later.
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Allow modifying the rule group from other sources than Terraform or the Grafana API.",
+				Description: "Allow modifying the rule group from other sources than Terraform or the Grafana API. Rule groups created in the UI can be adopted by importing them and leaving this unset. Rule groups provisioned from files can't be modified through the API.",
 			},
+			"provenance": provenanceAttribute(),
 			"rule": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -120,60 +122,7 @@ This resource requires Grafana 9.1.0 or later.
 							Required:    true,
 							Description: "The `ref_id` of the query node in the `data` field to use as the alert condition.",
 						},
-						"data": {
-							Type:             schema.TypeList,
-							Required:         true,
-							MinItems:         1,
-							Description:      "A sequence of stages that describe the contents of the rule.",
-							DiffSuppressFunc: diffSuppressJSON,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"ref_id": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "A unique string to identify this query stage within a rule.",
-									},
-									"datasource_uid": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The UID of the datasource being queried, or \"-100\" if this stage is an expression stage.",
-									},
-									"query_type": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Default:     "",
-										Description: "An optional identifier for the type of query being executed.",
-									},
-									"model": {
-										Required:     true,
-										Type:         schema.TypeString,
-										Description:  "Custom JSON data to send to the specified datasource when querying.",
-										ValidateFunc: validation.StringIsJSON,
-										StateFunc:    normalizeModelJSON,
-									},
-									"relative_time_range": {
-										Type:        schema.TypeList,
-										Required:    true,
-										Description: "The time range, relative to when the query is executed, across which to query.",
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"from": {
-													Type:        schema.TypeInt,
-													Required:    true,
-													Description: "The number of seconds in the past, relative to when the rule is evaluated, at which the time range begins.",
-												},
-												"to": {
-													Type:        schema.TypeInt,
-													Required:    true,
-													Description: "The number of seconds in the past, relative to when the rule is evaluated, at which the time range ends.",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
+						"data": alertRuleDataSchema(),
 						"labels": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -320,32 +269,47 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta int
 		return err
 	}
 
-	g := resp.Payload
-	data.Set("name", g.Title)
-	data.Set("folder_uid", g.FolderUID)
-	data.Set("interval_seconds", g.Interval)
-	disableProvenance := true
+	if err := packRuleGroup(client, resp.Payload, data); err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+	data.Set("disable_provenance", data.Get("provenance").(string) == provenanceNone)
+	data.SetId(resourceRuleGroupID.Make(orgID, folderUID, title))
+
+	return nil
+}
+
+// packRuleGroup sets the attributes shared by the rule group resource and data source.
+func packRuleGroup(client *goapi.GrafanaHTTPAPI, g *models.AlertRuleGroup, data *schema.ResourceData) error {
+	provenance := provenanceNone
 	rules := make([]interface{}, 0, len(g.Rules))
 	for _, r := range g.Rules {
 		ruleResp, err := client.Provisioning.GetAlertRule(r.UID) // We need to get the rule through a separate API call to get the provenance.
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 		r := ruleResp.Payload
-		data.Set("org_id", strconv.FormatInt(*r.OrgID, 10))
 		packed, err := packAlertRule(r)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
-		if r.Provenance != "" {
-			disableProvenance = false
+		if r.Provenance != provenanceNone {
+			provenance = string(r.Provenance)
 		}
 		rules = append(rules, packed)
 	}
-	data.Set("disable_provenance", disableProvenance)
-	data.Set("rule", rules)
-	data.SetId(resourceRuleGroupID.Make(orgID, folderUID, title))
 
+	for key, value := range map[string]interface{}{
+		"name":             g.Title,
+		"folder_uid":       g.FolderUID,
+		"interval_seconds": g.Interval,
+		"provenance":       provenance,
+		"rule":             rules,
+	} {
+		if err := data.Set(key, value); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -574,6 +538,64 @@ func packRuleData(queries []*models.AlertQuery) (interface{}, error) {
 	return result, nil
 }
 
+// alertRuleDataSchema is the schema of the query and expression stages of an alert rule.
+func alertRuleDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Required:         true,
+		MinItems:         1,
+		Description:      "A sequence of stages that describe the contents of the rule.",
+		DiffSuppressFunc: diffSuppressJSON,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ref_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "A unique string to identify this query stage within a rule.",
+				},
+				"datasource_uid": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The UID of the datasource being queried, or \"-100\" if this stage is an expression stage.",
+				},
+				"query_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "An optional identifier for the type of query being executed.",
+				},
+				"model": {
+					Required:     true,
+					Type:         schema.TypeString,
+					Description:  "Custom JSON data to send to the specified datasource when querying.",
+					ValidateFunc: validation.StringIsJSON,
+					StateFunc:    normalizeModelJSON,
+				},
+				"relative_time_range": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Description: "The time range, relative to when the query is executed, across which to query.",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"from": {
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "The number of seconds in the past, relative to when the rule is evaluated, at which the time range begins.",
+							},
+							"to": {
+								Type:        schema.TypeInt,
+								Required:    true,
+								Description: "The number of seconds in the past, relative to when the rule is evaluated, at which the time range ends.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func unpackRuleData(raw interface{}) ([]*models.AlertQuery, error) {
 	rows := raw.([]interface{})
 	result := make([]*models.AlertQuery, 0, len(rows))
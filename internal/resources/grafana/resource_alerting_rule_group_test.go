@@ -578,6 +578,7 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 					checkResourceIsInOrg("grafana_rule_group.test", "grafana_organization.test"),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "name", name),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "disable_provenance", "false"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "provenance", "api"),
 				),
 			},
 			// Test import.
@@ -595,6 +596,7 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 					checkResourceIsInOrg("grafana_rule_group.test", "grafana_organization.test"),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "name", name),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "disable_provenance", "true"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "provenance", ""),
 				),
 			},
 			// Test import.
@@ -612,6 +614,7 @@ func TestAccAlertRule_disableProvenance(t *testing.T) {
 					checkResourceIsInOrg("grafana_rule_group.test", "grafana_organization.test"),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "name", name),
 					resource.TestCheckResourceAttr("grafana_rule_group.test", "disable_provenance", "false"),
+					resource.TestCheckResourceAttr("grafana_rule_group.test", "provenance", "api"),
 				),
 			},
 		},
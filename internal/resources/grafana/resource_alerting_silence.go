@@ -0,0 +1,379 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	silenceStateActive  = "active"
+	silenceStatePending = "pending"
+	silenceStateExpired = "expired"
+)
+
+// alertmanagerSilence is the silence model of the Grafana Alertmanager API.
+// The OpenAPI client doesn't include the Alertmanager endpoints, so the model is defined here.
+type alertmanagerSilence struct {
+	ID        string                       `json:"id,omitempty"`
+	Matchers  []alertmanagerSilenceMatcher `json:"matchers"`
+	StartsAt  time.Time                    `json:"startsAt"`
+	EndsAt    time.Time                    `json:"endsAt"`
+	CreatedBy string                       `json:"createdBy"`
+	Comment   string                       `json:"comment"`
+	Status    *struct {
+		State string `json:"state"`
+	} `json:"status,omitempty"`
+}
+
+type alertmanagerSilenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+func resourceSilence() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages Grafana Alerting silences.
+
+Silences are never deleted by the Alertmanager, they are expired instead. Destroying this resource expires the silence.
+Once a silence reaches its end time, it is kept in the Terraform state as ` + "`expired`" + `. If a silence is expired before its end time (ex: from the UI), it is recreated on the next apply.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/configure-notifications/create-silence/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/alerting_alertmanager/)
+`,
+
+		CreateContext: createSilence,
+		ReadContext:   readSilence,
+		UpdateContext: updateSilence,
+		DeleteContext: deleteSilence,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"matcher": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Describes which alerts are silenced. An alert must match ALL matchers to be silenced.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the label to match against.",
+						},
+						"match": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The operator to apply when matching values of the given label. Allowed operators are `=` for equality, `!=` for negated equality, `=~` for regex equality, and `!~` for negated regex equality.",
+							ValidateFunc: validation.StringInSlice([]string{"=", "!=", "=~", "!~"}, false),
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The label value to match against.",
+						},
+					},
+				},
+			},
+			"starts_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressSilenceStartDiff,
+				Description:      "The RFC 3339-formatted time at which the silence starts. Defaults to the creation time. Start times in the past are set to the current time by the Alertmanager.",
+			},
+			"ends_at": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiff,
+				Description:      "The RFC 3339-formatted time at which the silence ends.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A comment describing the reason for the silence.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Terraform",
+				Description: "The author of the silence.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the silence. One of `pending`, `active` or `expired`.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_alerting_silence",
+		orgResourceIDString("id"),
+		schema,
+	).WithLister(listerFunctionOrgResource(listSilences))
+}
+
+func listSilences(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	var ids []string
+	// Retry if the API returns 500 because it may be that the alertmanager is not ready in the org yet.
+	// The alertmanager is provisioned asynchronously when the org is created.
+	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		silences, err := getSilences(ctx, client, nil)
+		if err != nil {
+			if apiErr, ok := err.(*runtime.APIError); ok && orgID > 1 && (apiErr.IsCode(500) || apiErr.IsCode(403)) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		for _, silence := range silences {
+			if silence.Status != nil && silence.Status.State == silenceStateExpired {
+				continue
+			}
+			ids = append(ids, MakeOrgResourceID(orgID, silence.ID))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func readSilence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	silence, err := getSilence(ctx, client, id)
+	if err != nil && common.IsNotFoundError(err) && silenceEnded(d) {
+		// Expired silences are garbage collected by the Alertmanager after a while.
+		// The silence has lived its full life, so there is nothing to recreate.
+		d.Set("state", silenceStateExpired)
+		return nil
+	}
+	if err, shouldReturn := common.CheckReadError("silence", d, err); shouldReturn {
+		return err
+	}
+
+	// A silence that was expired before its end time (ex: from the UI) is recreated
+	if silence.Status != nil && silence.Status.State == silenceStateExpired && !silenceEnded(d) {
+		return common.WarnMissing("silence", d)
+	}
+
+	matchers := make([]interface{}, 0, len(silence.Matchers))
+	for _, m := range silence.Matchers {
+		matchers = append(matchers, packSilenceMatcher(m))
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, silence.ID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("matcher", matchers)
+	d.Set("starts_at", silence.StartsAt.UTC().Format(time.RFC3339))
+	d.Set("ends_at", silence.EndsAt.UTC().Format(time.RFC3339))
+	d.Set("comment", silence.Comment)
+	d.Set("created_by", silence.CreatedBy)
+	if silence.Status != nil {
+		d.Set("state", silence.Status.State)
+	}
+
+	return nil
+}
+
+func createSilence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	silence, err := unpackSilence(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var id string
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var postErr error
+		id, postErr = postSilence(ctx, client, silence)
+		if orgID > 1 && postErr != nil {
+			if apiError, ok := postErr.(*runtime.APIError); ok && (apiError.IsCode(500) || apiError.IsCode(404)) {
+				return retry.RetryableError(postErr)
+			}
+		}
+		if postErr != nil {
+			return retry.NonRetryableError(postErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, id))
+	return readSilence(ctx, d, meta)
+}
+
+func updateSilence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	silence, err := unpackSilence(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Expired silences can't be updated, a new one is created instead
+	if d.Get("state").(string) != silenceStateExpired {
+		silence.ID = id
+	}
+
+	// The Alertmanager may expire the silence and create a new one with a different ID (ex: when the matchers of an active silence change)
+	newID, err := postSilence(ctx, client, silence)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, newID))
+	return readSilence(ctx, d, meta)
+}
+
+func deleteSilence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Expired silences can't be expired again
+	if d.Get("state").(string) == silenceStateExpired {
+		return nil
+	}
+
+	client, _, id := OAPIClientFromExistingOrgResource(meta, d.Id())
+	err := grafanaAPIRequest(ctx, client, "DELETE", "/alertmanager/grafana/api/v2/silence/"+url.PathEscape(id), nil, nil)
+	diag, _ := common.CheckReadError("silence", d, err)
+	return diag
+}
+
+func getSilence(ctx context.Context, client *goapi.GrafanaHTTPAPI, id string) (*alertmanagerSilence, error) {
+	var silence alertmanagerSilence
+	if err := grafanaAPIRequest(ctx, client, "GET", "/alertmanager/grafana/api/v2/silence/"+url.PathEscape(id), nil, &silence); err != nil {
+		return nil, err
+	}
+	return &silence, nil
+}
+
+// getSilences lists the silences of the org. The filters are matchers in the Alertmanager syntax (ex: `alertname=~"foo.*"`).
+func getSilences(ctx context.Context, client *goapi.GrafanaHTTPAPI, filters []string) ([]alertmanagerSilence, error) {
+	path := "/alertmanager/grafana/api/v2/silences"
+	if len(filters) > 0 {
+		path += "?" + url.Values{"filter": filters}.Encode()
+	}
+
+	var silences []alertmanagerSilence
+	if err := grafanaAPIRequest(ctx, client, "GET", path, nil, &silences); err != nil {
+		return nil, err
+	}
+	return silences, nil
+}
+
+// postSilence creates or updates a silence and returns its ID.
+func postSilence(ctx context.Context, client *goapi.GrafanaHTTPAPI, silence *alertmanagerSilence) (string, error) {
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := grafanaAPIRequest(ctx, client, "POST", "/alertmanager/grafana/api/v2/silences", silence, &resp); err != nil {
+		return "", err
+	}
+	return resp.SilenceID, nil
+}
+
+func unpackSilence(d *schema.ResourceData) (*alertmanagerSilence, error) {
+	silence := &alertmanagerSilence{
+		Comment:   d.Get("comment").(string),
+		CreatedBy: d.Get("created_by").(string),
+		StartsAt:  time.Now().UTC(),
+	}
+
+	if v := d.Get("starts_at").(string); v != "" {
+		startsAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, err
+		}
+		silence.StartsAt = startsAt
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, d.Get("ends_at").(string))
+	if err != nil {
+		return nil, err
+	}
+	if !endsAt.After(silence.StartsAt) {
+		return nil, fmt.Errorf("ends_at (%s) must be after starts_at (%s)", endsAt.Format(time.RFC3339), silence.StartsAt.Format(time.RFC3339))
+	}
+	silence.EndsAt = endsAt
+
+	for _, m := range d.Get("matcher").(*schema.Set).List() {
+		silence.Matchers = append(silence.Matchers, unpackSilenceMatcher(m))
+	}
+
+	return silence, nil
+}
+
+func packSilenceMatcher(m alertmanagerSilenceMatcher) interface{} {
+	match := "="
+	switch {
+	case m.IsRegex && m.IsEqual:
+		match = "=~"
+	case m.IsRegex:
+		match = "!~"
+	case !m.IsEqual:
+		match = "!="
+	}
+
+	return map[string]interface{}{
+		"label": m.Name,
+		"match": match,
+		"value": m.Value,
+	}
+}
+
+func unpackSilenceMatcher(raw interface{}) alertmanagerSilenceMatcher {
+	json := raw.(map[string]interface{})
+	match := json["match"].(string)
+	return alertmanagerSilenceMatcher{
+		Name:    json["label"].(string),
+		Value:   json["value"].(string),
+		IsRegex: match == "=~" || match == "!~",
+		IsEqual: match == "=" || match == "=~",
+	}
+}
+
+// silenceEnded returns true if the end time of the silence in the state is in the past.
+func silenceEnded(d *schema.ResourceData) bool {
+	endsAt, err := time.Parse(time.RFC3339, d.Get("ends_at").(string))
+	return err == nil && !endsAt.After(time.Now())
+}
+
+func suppressEquivalentTimeDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldTime, oldErr := time.Parse(time.RFC3339, oldValue)
+	newTime, newErr := time.Parse(time.RFC3339, newValue)
+	return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+}
+
+// suppressSilenceStartDiff ignores start times in the past. The Alertmanager replaces them with the current time.
+func suppressSilenceStartDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if suppressEquivalentTimeDiff(k, oldValue, newValue, d) {
+		return true
+	}
+	oldTime, oldErr := time.Parse(time.RFC3339, oldValue)
+	newTime, newErr := time.Parse(time.RFC3339, newValue)
+	now := time.Now()
+	return oldErr == nil && newErr == nil && !oldTime.After(now) && !newTime.After(now)
+}
@@ -0,0 +1,110 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSilence_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	// The silences data source test filters on the service, it must not match this silence
+	service := acctest.RandomWithPrefix("database")
+	config := testutils.TestAccExampleWithReplace(t, "resources/grafana_alerting_silence/resource.tf", map[string]string{
+		`value = "database"`: `value = "` + service + `"`,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test creation.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_alerting_silence.maintenance", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "state", "pending"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "starts_at", "2030-01-01T02:00:00Z"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "ends_at", "2030-01-01T04:00:00Z"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "comment", "Database maintenance window"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "created_by", "platform-team"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "matcher.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_alerting_silence.maintenance", "matcher.*", map[string]string{
+						"label": "service",
+						"match": "=",
+						"value": service,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("grafana_alerting_silence.maintenance", "matcher.*", map[string]string{
+						"label": "severity",
+						"match": "=~",
+						"value": "warning|critical",
+					}),
+					testutils.CheckLister("grafana_alerting_silence.maintenance"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "grafana_alerting_silence.maintenance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Test plan (should be empty)
+			{
+				Config:   testutils.TestAccExample(t, "resources/grafana_alerting_silence/resource.tf"),
+				PlanOnly: true,
+			},
+			// Test update.
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_alerting_silence/resource.tf", map[string]string{
+					`value = "database"`:          `value = "` + service + `"`,
+					"04:00:00Z":                   "06:00:00Z",
+					"Database maintenance window": "Extended maintenance window",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "state", "pending"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "ends_at", "2030-01-01T06:00:00Z"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.maintenance", "comment", "Extended maintenance window"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSilence_inOrg(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	orgName := acctest.RandomWithPrefix("silence-test-org")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_organization" "test" {
+	name = "%s"
+}
+
+resource "grafana_alerting_silence" "test" {
+	org_id  = grafana_organization.test.id
+	ends_at = "2030-01-01T00:00:00Z"
+	comment = "Silence in org"
+
+	matcher {
+		label = "alertname"
+		match = "!="
+		value = "Watchdog"
+	}
+}`, orgName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_alerting_silence.test", "id", nonDefaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.test", "state", "active"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.test", "created_by", "Terraform"),
+					resource.TestCheckResourceAttr("grafana_alerting_silence.test", "matcher.0.match", "!="),
+				),
+			},
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
@@ -34,6 +35,10 @@ Manages Grafana dashboards.
 		ReadContext:   ReadDashboard,
 		UpdateContext: UpdateDashboard,
 		DeleteContext: DeleteDashboard,
+		CustomizeDiff: customdiff.All(
+			diffDashboardIgnoreJSONPaths,
+			validateDashboardCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -78,7 +83,29 @@ Manages Grafana dashboards.
 				Required:     true,
 				StateFunc:    NormalizeDashboardConfigJSON,
 				ValidateFunc: validateDashboardConfigJSON,
-				Description:  "The complete dashboard model JSON.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return dashboardConfigJSONEqualIgnoringPaths(old, new, d)
+				},
+				Description: "The complete dashboard model JSON.",
+			},
+			"ignore_json_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Description: "Paths of the dashboard model JSON that are ignored when comparing with the dashboard in Grafana, in JSONPath (ex: `$.time`, `$.panels[*].pluginVersion`) or JSON pointer (ex: `/time`, `/panels/*/pluginVersion`) syntax. " +
+					"Values at these paths can be changed in the Grafana UI without Terraform reverting them. They are only sent to Grafana when the dashboard is created. " +
+					"Defaults to the provider's `dashboard_ignore_json_paths`. Not supported when the provider's `store_dashboard_sha256` is set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDashboardJSONPath,
+				},
+			},
+			"validate_config_json": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Set to true to validate the structure of `config_json` at plan time: missing or unknown panel types, duplicate panel IDs, " +
+					"invalid or overlapping `gridPos` (for schema versions with a grid layout). References to data sources that don't exist are reported too: data sources created in the same apply must be referenced through their attributes (ex: `grafana_data_source.x.uid`), so that they are checked once created.",
 			},
 			"overwrite": {
 				Type:        schema.TypeBool,
@@ -153,6 +180,9 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}
 	d.Set("version", int64(model["version"].(float64)))
 	d.Set("url", metaClient.GrafanaSubpath(dashboard.Meta.URL))
 	d.Set("folder", dashboard.Meta.FolderUID)
+	if _, ok := d.GetOk("validate_config_json"); !ok {
+		d.Set("validate_config_json", false) // Not stored in Grafana, set the default for imports
+	}
 
 	configJSONBytes, err := json.Marshal(dashboard.Dashboard)
 	if err != nil {
@@ -179,6 +209,13 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta interface{}
 		if _, ok := configuredDashJSON["uid"].(string); !ok {
 			delete(remoteDashJSON, "uid")
 		}
+
+		// Values at ignored paths are kept as configured, so that changes made in Grafana don't create a diff
+		ignorePaths, err := dashboardIgnoreJSONPaths(d, meta.(*common.Client).DashboardIgnoreJSONPaths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		copyDashboardJSONPaths(remoteDashJSON, configuredDashJSON, ignorePaths)
 	}
 	configJSON = NormalizeDashboardConfigJSON(remoteDashJSON)
 	d.Set("config_json", configJSON)
@@ -195,6 +232,21 @@ func UpdateDashboard(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 	dashboard.Dashboard.(map[string]interface{})["id"] = d.Get("dashboard_id").(int)
 	dashboard.Overwrite = true
+
+	// Values at ignored paths are kept as they are in Grafana
+	ignorePaths, err := dashboardIgnoreJSONPaths(d, meta.(*common.Client).DashboardIgnoreJSONPaths)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(ignorePaths) > 0 {
+		_, uid := SplitOrgResourceID(d.Id())
+		resp, err := client.Dashboards.GetDashboardByUID(uid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		copyDashboardJSONPaths(dashboard.Dashboard.(map[string]interface{}), resp.Payload.Dashboard.(map[string]interface{}), ignorePaths)
+	}
+
 	resp, err := client.Dashboards.PostDashboard(&dashboard)
 	if err != nil {
 		return diag.FromErr(err)
@@ -251,6 +303,29 @@ func validateDashboardConfigJSON(config interface{}, k string) ([]string, []erro
 	return nil, nil
 }
 
+// dashboardConfigJSONEqualIgnoringPaths is the DiffSuppressFunc for `config_json`.
+// It compares both values without the paths set in `ignore_json_paths`, which holds the provider-level default when it isn't configured.
+func dashboardConfigJSONEqualIgnoringPaths(old, new string, d *schema.ResourceData) bool {
+	if old == "" || common.SHA256Regexp.MatchString(old) {
+		return false
+	}
+	ignorePaths, err := dashboardIgnoreJSONPaths(d, nil)
+	if err != nil || len(ignorePaths) == 0 {
+		return false
+	}
+	oldJSON, err := UnmarshalDashboardConfigJSON(old)
+	if err != nil {
+		return false
+	}
+	newJSON, err := UnmarshalDashboardConfigJSON(new)
+	if err != nil {
+		return false
+	}
+	copyDashboardJSONPaths(oldJSON, nil, ignorePaths)
+	copyDashboardJSONPaths(newJSON, nil, ignorePaths)
+	return NormalizeDashboardConfigJSON(oldJSON) == NormalizeDashboardConfigJSON(newJSON)
+}
+
 // NormalizeDashboardConfigJSON is the StateFunc for the `config_json` field.
 //
 // It removes the following fields:
@@ -0,0 +1,204 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dashboardJSONPathSegment is a single step of a parsed dashboard JSON path.
+// A wildcard matches every key of an object or every element of an array.
+// Other keys match object keys, or array indexes if they are numeric.
+type dashboardJSONPathSegment struct {
+	key      string
+	wildcard bool
+}
+
+// parseDashboardJSONPath parses a path in JSONPath (`$.panels[*].pluginVersion`) or JSON pointer (`/panels/*/pluginVersion`) syntax.
+func parseDashboardJSONPath(path string) ([]dashboardJSONPathSegment, error) {
+	var segments []dashboardJSONPathSegment
+	switch {
+	case strings.HasPrefix(path, "/"):
+		for _, part := range strings.Split(path[1:], "/") {
+			if part == "*" {
+				segments = append(segments, dashboardJSONPathSegment{wildcard: true})
+				continue
+			}
+			part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+			segments = append(segments, dashboardJSONPathSegment{key: part})
+		}
+	case strings.HasPrefix(path, "$"):
+		rest := path[1:]
+		for rest != "" {
+			switch {
+			case strings.HasPrefix(rest, ".."):
+				return nil, fmt.Errorf("invalid JSON path %q: recursive descent is not supported", path)
+			case rest[0] == '.':
+				end := strings.IndexAny(rest[1:], ".[")
+				if end == -1 {
+					end = len(rest) - 1
+				}
+				key := rest[1 : end+1]
+				if key == "" {
+					return nil, fmt.Errorf("invalid JSON path %q: empty key", path)
+				}
+				if key == "*" {
+					segments = append(segments, dashboardJSONPathSegment{wildcard: true})
+				} else {
+					segments = append(segments, dashboardJSONPathSegment{key: key})
+				}
+				rest = rest[end+1:]
+			case rest[0] == '[':
+				end := strings.Index(rest, "]")
+				if end == -1 {
+					return nil, fmt.Errorf("invalid JSON path %q: unclosed bracket", path)
+				}
+				key := rest[1:end]
+				switch {
+				case key == "*":
+					segments = append(segments, dashboardJSONPathSegment{wildcard: true})
+				case len(key) >= 2 && (key[0] == '\'' || key[0] == '"') && key[len(key)-1] == key[0]:
+					segments = append(segments, dashboardJSONPathSegment{key: key[1 : len(key)-1]})
+				default:
+					if _, err := strconv.Atoi(key); err != nil {
+						return nil, fmt.Errorf("invalid JSON path %q: %q is not an index, a quoted key or a wildcard", path, key)
+					}
+					segments = append(segments, dashboardJSONPathSegment{key: key})
+				}
+				rest = rest[end+1:]
+			default:
+				return nil, fmt.Errorf("invalid JSON path %q: unexpected character %q", path, rest[0])
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid JSON path %q: must start with `$` (JSONPath) or `/` (JSON pointer)", path)
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("invalid JSON path %q: the whole dashboard can't be ignored", path)
+	}
+	return segments, nil
+}
+
+func validateDashboardJSONPath(v interface{}, k string) ([]string, []error) {
+	if _, err := parseDashboardJSONPath(v.(string)); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// ValidateDashboardJSONPaths checks the paths of the provider-level `dashboard_ignore_json_paths`.
+func ValidateDashboardJSONPaths(paths []string) error {
+	for _, path := range paths {
+		if _, err := parseDashboardJSONPath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffDashboardIgnoreJSONPaths sets `ignore_json_paths` to the provider-level default when it isn't configured,
+// so that the paths used by the DiffSuppressFunc of `config_json` are known from the state.
+func diffDashboardIgnoreJSONPaths(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.GetRawConfig().GetAttr("ignore_json_paths").IsNull() {
+		return nil
+	}
+	defaults := meta.(*common.Client).DashboardIgnoreJSONPaths
+	current := common.ListToStringSlice(d.Get("ignore_json_paths").([]interface{}))
+	if slices.Equal(current, defaults) {
+		return nil
+	}
+	return d.SetNew("ignore_json_paths", defaults)
+}
+
+// dashboardIgnoreJSONPaths returns the parsed paths to ignore for a dashboard.
+// The provider-level default is used when the dashboard doesn't have any, which is the case of imported dashboards.
+func dashboardIgnoreJSONPaths(d *schema.ResourceData, defaults []string) ([][]dashboardJSONPathSegment, error) {
+	paths := defaults
+	if v, ok := d.GetOk("ignore_json_paths"); ok {
+		paths = common.ListToStringSlice(v.([]interface{}))
+	}
+
+	var parsed [][]dashboardJSONPathSegment
+	for _, path := range paths {
+		segments, err := parseDashboardJSONPath(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, segments)
+	}
+	return parsed, nil
+}
+
+// copyDashboardJSONPaths replaces the values matched by the given paths in `dst` with the ones in `src`.
+// Values that don't exist in `src` are removed from `dst`. A nil `src` removes all matched values.
+func copyDashboardJSONPaths(dst, src map[string]interface{}, paths [][]dashboardJSONPathSegment) {
+	for _, segments := range paths {
+		copyDashboardJSONPath(dst, src, segments)
+	}
+}
+
+func copyDashboardJSONPath(dst, src interface{}, segments []dashboardJSONPathSegment) {
+	segment, last := segments[0], len(segments) == 1
+
+	switch dstValue := dst.(type) {
+	case map[string]interface{}:
+		srcMap, _ := src.(map[string]interface{})
+		keys := []string{segment.key}
+		if segment.wildcard {
+			keys = nil
+			for key := range dstValue {
+				keys = append(keys, key)
+			}
+			if last {
+				for key := range srcMap {
+					if _, ok := dstValue[key]; !ok {
+						keys = append(keys, key)
+					}
+				}
+			}
+		}
+		for _, key := range keys {
+			srcChild, srcOK := srcMap[key]
+			if last {
+				if srcOK {
+					dstValue[key] = srcChild
+				} else {
+					delete(dstValue, key)
+				}
+				continue
+			}
+			if dstChild, ok := dstValue[key]; ok {
+				copyDashboardJSONPath(dstChild, srcChild, segments[1:])
+			}
+		}
+	case []interface{}:
+		srcList, _ := src.([]interface{})
+		indexes := []int{}
+		if segment.wildcard {
+			for i := range dstValue {
+				indexes = append(indexes, i)
+			}
+		} else if i, err := strconv.Atoi(segment.key); err == nil && i >= 0 && i < len(dstValue) {
+			indexes = append(indexes, i)
+		}
+		for _, i := range indexes {
+			var srcChild interface{}
+			if i < len(srcList) {
+				srcChild = srcList[i]
+			}
+			if last {
+				// Array elements can't be removed without shifting the others, so they are only replaced
+				if srcChild != nil {
+					dstValue[i] = srcChild
+				}
+				continue
+			}
+			copyDashboardJSONPath(dstValue[i], srcChild, segments[1:])
+		}
+	}
+}
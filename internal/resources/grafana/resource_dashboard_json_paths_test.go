@@ -0,0 +1,146 @@
+package grafana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseDashboardJSONPath(t *testing.T) {
+	wildcard := dashboardJSONPathSegment{wildcard: true}
+	key := func(k string) dashboardJSONPathSegment { return dashboardJSONPathSegment{key: k} }
+
+	tests := []struct {
+		path    string
+		want    []dashboardJSONPathSegment
+		wantErr string
+	}{
+		{path: "$.time", want: []dashboardJSONPathSegment{key("time")}},
+		{path: "$.panels[*].pluginVersion", want: []dashboardJSONPathSegment{key("panels"), wildcard, key("pluginVersion")}},
+		{path: "$.panels.*.gridPos", want: []dashboardJSONPathSegment{key("panels"), wildcard, key("gridPos")}},
+		{path: "$.panels[0]['title']", want: []dashboardJSONPathSegment{key("panels"), key("0"), key("title")}},
+		{path: `$["time.zone"]`, want: []dashboardJSONPathSegment{key("time.zone")}},
+		{path: "/time", want: []dashboardJSONPathSegment{key("time")}},
+		{path: "/panels/*/pluginVersion", want: []dashboardJSONPathSegment{key("panels"), wildcard, key("pluginVersion")}},
+		{path: "/a~1b/c~0d", want: []dashboardJSONPathSegment{key("a/b"), key("c~d")}},
+		{path: "time", wantErr: "invalid JSON path \"time\": must start with `$` (JSONPath) or `/` (JSON pointer)"},
+		{path: "$", wantErr: `invalid JSON path "$": the whole dashboard can't be ignored`},
+		{path: "$..time", wantErr: `invalid JSON path "$..time": recursive descent is not supported`},
+		{path: "$.panels.", wantErr: `invalid JSON path "$.panels.": empty key`},
+		{path: "$.panels[0", wantErr: `invalid JSON path "$.panels[0": unclosed bracket`},
+		{path: "$.panels[first]", wantErr: `invalid JSON path "$.panels[first]": "first" is not an index, a quoted key or a wildcard`},
+		{path: "$time", wantErr: `invalid JSON path "$time": unexpected character 't'`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parseDashboardJSONPath(tt.path)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_copyDashboardJSONPaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		dst   map[string]interface{}
+		src   map[string]interface{}
+		want  map[string]interface{}
+	}{
+		{
+			name:  "top-level key",
+			paths: []string{"$.time"},
+			dst:   map[string]interface{}{"title": "a", "time": "now-1h"},
+			src:   map[string]interface{}{"title": "b", "time": "now-6h"},
+			want:  map[string]interface{}{"title": "a", "time": "now-6h"},
+		},
+		{
+			name:  "missing values are removed",
+			paths: []string{"/time"},
+			dst:   map[string]interface{}{"title": "a", "time": "now-1h"},
+			src:   map[string]interface{}{"title": "b"},
+			want:  map[string]interface{}{"title": "a"},
+		},
+		{
+			name:  "nil source removes the values",
+			paths: []string{"$.panels[*].pluginVersion"},
+			dst: map[string]interface{}{"panels": []interface{}{
+				map[string]interface{}{"id": 1.0, "pluginVersion": "10.0.0"},
+				map[string]interface{}{"id": 2.0},
+			}},
+			want: map[string]interface{}{"panels": []interface{}{
+				map[string]interface{}{"id": 1.0},
+				map[string]interface{}{"id": 2.0},
+			}},
+		},
+		{
+			name:  "wildcard in arrays",
+			paths: []string{"$.panels[*].pluginVersion"},
+			dst: map[string]interface{}{"panels": []interface{}{
+				map[string]interface{}{"id": 1.0, "pluginVersion": "10.0.0"},
+				map[string]interface{}{"id": 2.0},
+			}},
+			src: map[string]interface{}{"panels": []interface{}{
+				map[string]interface{}{"id": 1.0, "pluginVersion": "11.0.0"},
+				map[string]interface{}{"id": 2.0, "pluginVersion": "11.0.0"},
+			}},
+			want: map[string]interface{}{"panels": []interface{}{
+				map[string]interface{}{"id": 1.0, "pluginVersion": "11.0.0"},
+				map[string]interface{}{"id": 2.0, "pluginVersion": "11.0.0"},
+			}},
+		},
+		{
+			name:  "wildcard in objects adds the keys of the source",
+			paths: []string{"$.annotations.*"},
+			dst:   map[string]interface{}{"annotations": map[string]interface{}{"a": 1.0, "b": 2.0}},
+			src:   map[string]interface{}{"annotations": map[string]interface{}{"b": 3.0, "c": 4.0}},
+			want:  map[string]interface{}{"annotations": map[string]interface{}{"b": 3.0, "c": 4.0}},
+		},
+		{
+			name:  "array indexes",
+			paths: []string{"$.panels[1]", "$.panels[5]"},
+			dst:   map[string]interface{}{"panels": []interface{}{"a", "b"}},
+			src:   map[string]interface{}{"panels": []interface{}{"c", "d", "e"}},
+			want:  map[string]interface{}{"panels": []interface{}{"a", "d"}},
+		},
+		{
+			name:  "array elements are not removed",
+			paths: []string{"$.panels[*]"},
+			dst:   map[string]interface{}{"panels": []interface{}{"a", "b"}},
+			src:   map[string]interface{}{"panels": []interface{}{"c"}},
+			want:  map[string]interface{}{"panels": []interface{}{"c", "b"}},
+		},
+		{
+			name:  "missing parents are not created",
+			paths: []string{"$.time.from"},
+			dst:   map[string]interface{}{"title": "a"},
+			src:   map[string]interface{}{"time": map[string]interface{}{"from": "now-6h"}},
+			want:  map[string]interface{}{"title": "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths [][]dashboardJSONPathSegment
+			for _, path := range tt.paths {
+				segments, err := parseDashboardJSONPath(path)
+				require.NoError(t, err)
+				paths = append(paths, segments)
+			}
+
+			copyDashboardJSONPaths(tt.dst, tt.src, paths)
+			assert.Equal(t, tt.want, tt.dst)
+		})
+	}
+}
+
+func Test_ValidateDashboardJSONPaths(t *testing.T) {
+	require.NoError(t, ValidateDashboardJSONPaths(nil))
+	require.NoError(t, ValidateDashboardJSONPaths([]string{"$.time", "/panels/*/pluginVersion"}))
+	require.EqualError(t, ValidateDashboardJSONPaths([]string{"$.time", "time"}), "invalid JSON path \"time\": must start with `$` (JSONPath) or `/` (JSON pointer)")
+}
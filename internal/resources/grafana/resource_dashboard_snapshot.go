@@ -0,0 +1,211 @@
+package grafana
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDashboardSnapshot() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages Grafana dashboard snapshots. A snapshot is a frozen copy of a dashboard, which can be shared without giving access to the dashboard.
+
+Snapshots can't be modified, so any change recreates the snapshot. When a snapshot expires, it's removed from the state and created again on the next apply.
+
+**Note:** Snapshots only contain the data embedded in the dashboard model (` + "`snapshotData`" + ` of panels), as queries aren't run when the snapshot is created.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/share-dashboards-panels/#publish-a-snapshot)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/snapshot/)
+`,
+
+		CreateContext: createDashboardSnapshot,
+		ReadContext:   readDashboardSnapshot,
+		DeleteContext: deleteDashboardSnapshot,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"dashboard_uid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The UID of the dashboard to snapshot. Its current model is used.",
+				ExactlyOneOf: []string{"dashboard_uid", "config_json"},
+			},
+			"config_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				StateFunc:    NormalizeDashboardConfigJSON,
+				ValidateFunc: validateDashboardConfigJSON,
+				Description:  "The dashboard model JSON to snapshot.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the snapshot. Defaults to the title of the dashboard.",
+			},
+			"expires": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				Description:  "The number of seconds after which the snapshot expires. `0` means the snapshot never expires.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"external": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Set to true to publish the snapshot on the external snapshot server configured in Grafana (ex: snapshots.raintank.io) instead of the Grafana instance.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique key of the snapshot, used in its URL. It's generated by Grafana if not set.",
+			},
+			"delete_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The key used to delete the snapshot without authentication. It's generated by Grafana if not set.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the snapshot.",
+			},
+			"delete_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL which deletes the snapshot when opened.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the snapshot expires. Empty if it never expires.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_dashboard_snapshot",
+		nil, // Not importable, the delete key and the dashboard source can't be read back
+		schema,
+	)
+}
+
+// dashboardSnapshotCreateResponse is the response of the snapshot creation API.
+type dashboardSnapshotCreateResponse struct {
+	Key       string `json:"key"`
+	DeleteKey string `json:"deleteKey"`
+	URL       string `json:"url"`
+	DeleteURL string `json:"deleteUrl"`
+}
+
+// dashboardSnapshotResponse is the response of the snapshot API.
+type dashboardSnapshotResponse struct {
+	Meta struct {
+		Expires time.Time `json:"expires"`
+	} `json:"meta"`
+}
+
+func createDashboardSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaClient := meta.(*common.Client)
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+
+	var model map[string]interface{}
+	if uid, ok := d.GetOk("dashboard_uid"); ok {
+		resp, err := client.Dashboards.GetDashboardByUID(uid.(string))
+		if err != nil {
+			return diag.Errorf("failed to get dashboard %q: %s", uid, err)
+		}
+		model = resp.Payload.Dashboard.(map[string]interface{})
+	} else {
+		var err error
+		if model, err = UnmarshalDashboardConfigJSON(d.Get("config_json").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name, _ = model["title"].(string)
+	}
+	body := map[string]interface{}{
+		"dashboard": model,
+		"name":      name,
+		"expires":   d.Get("expires").(int),
+		"external":  d.Get("external").(bool),
+	}
+	if key := d.Get("key").(string); key != "" {
+		body["key"] = key
+	}
+	if deleteKey := d.Get("delete_key").(string); deleteKey != "" {
+		body["deleteKey"] = deleteKey
+	}
+
+	// The generated model of the command nests the dashboard in an `Object` key, so the API is called directly
+	var resp dashboardSnapshotCreateResponse
+	if err := grafanaAPIRequest(ctx, client, "POST", "/snapshots", body, &resp); err != nil {
+		return diag.Errorf("failed to create snapshot: %s", err)
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, resp.Key))
+	d.Set("name", name)
+	d.Set("key", resp.Key)
+	d.Set("delete_key", resp.DeleteKey)
+	d.Set("url", resp.URL)
+	d.Set("delete_url", resp.DeleteURL)
+	if !d.Get("external").(bool) {
+		// Grafana builds the URLs from its root URL, which may differ from the provider's URL
+		d.Set("url", metaClient.GrafanaSubpath("/dashboard/snapshot/"+resp.Key))
+		d.Set("delete_url", metaClient.GrafanaSubpath("/api/snapshots-delete/"+resp.DeleteKey))
+	}
+
+	return readDashboardSnapshot(ctx, d, meta)
+}
+
+func readDashboardSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, key := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	var resp dashboardSnapshotResponse
+	err := grafanaAPIRequest(ctx, client, "GET", "/snapshots/"+url.PathEscape(key), nil, &resp)
+	if err, shouldReturn := common.CheckReadError("dashboard snapshot", d, err); shouldReturn {
+		return err
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, key))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("key", key)
+	expiresAt := ""
+	// Grafana sets the expiry date of snapshots which never expire 50 years in the future
+	if !resp.Meta.Expires.IsZero() && resp.Meta.Expires.Before(time.Now().AddDate(49, 0, 0)) {
+		expiresAt = resp.Meta.Expires.Format(time.RFC3339)
+	}
+	d.Set("expires_at", expiresAt)
+
+	return nil
+}
+
+func deleteDashboardSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, key := OAPIClientFromExistingOrgResource(meta, d.Id())
+	_, err := client.Snapshots.DeleteDashboardSnapshot(key)
+	diag, _ := common.CheckReadError("dashboard snapshot", d, err)
+	return diag
+}
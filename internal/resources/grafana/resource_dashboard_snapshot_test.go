@@ -0,0 +1,68 @@
+package grafana_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDashboardSnapshot_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			dashboardCheckExists.destroyed(&dashboard, nil),
+			testAccDashboardSnapshotCheckDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_dashboard_snapshot/resource.tf", map[string]string{
+					"incident-overview": uid,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.incident", &dashboard),
+
+					resource.TestMatchResourceAttr("grafana_dashboard_snapshot.from_dashboard", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.from_dashboard", "name", "Incident 1234"),
+					resource.TestCheckResourceAttrSet("grafana_dashboard_snapshot.from_dashboard", "key"),
+					resource.TestCheckResourceAttrSet("grafana_dashboard_snapshot.from_dashboard", "delete_key"),
+					resource.TestMatchResourceAttr("grafana_dashboard_snapshot.from_dashboard", "url", regexp.MustCompile("^"+regexp.QuoteMeta(strings.TrimRight(os.Getenv("GRAFANA_URL"), "/"))+"/dashboard/snapshot/.+")),
+					resource.TestMatchResourceAttr("grafana_dashboard_snapshot.from_dashboard", "expires_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.from_json", "name", "Frozen view"),
+					resource.TestCheckResourceAttr("grafana_dashboard_snapshot.from_json", "expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardSnapshotCheckDestroy(s *terraform.State) error {
+	client := grafanaTestClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "grafana_dashboard_snapshot" {
+			continue
+		}
+		key := rs.Primary.Attributes["key"]
+		_, err := client.Snapshots.GetDashboardSnapshot(key)
+		if err == nil {
+			return fmt.Errorf("dashboard snapshot %s still exists", key)
+		} else if !common.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
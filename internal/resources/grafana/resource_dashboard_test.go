@@ -3,6 +3,7 @@ package grafana_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -246,6 +247,106 @@ func TestAccDashboard_inOrg(t *testing.T) {
 	})
 }
 
+func TestAccDashboard_ignoreJSONPaths(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+	client := grafanaTestClient()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardIgnoreJSONPaths(uid, "Ignore JSON Paths"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "ignore_json_paths.#", "2"),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "config_json", fmt.Sprintf(`{"panels":[{"pluginVersion":"10.0.0","title":"Panel","type":"text"}],"time":{"from":"now-6h","to":"now"},"title":"Ignore JSON Paths","uid":"%s"}`, uid)),
+				),
+			},
+			{
+				// Change the time range and the plugin version in Grafana, as if done in the UI.
+				PreConfig: func() {
+					model := dashboard.Dashboard.(map[string]interface{})
+					model["time"] = map[string]interface{}{"from": "now-24h", "to": "now"}
+					model["panels"].([]interface{})[0].(map[string]interface{})["pluginVersion"] = "11.0.0"
+					if _, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: model, Overwrite: true}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccDashboardIgnoreJSONPaths(uid, "Ignore JSON Paths"),
+				PlanOnly: true,
+			},
+			{
+				// Other changes are applied, and the ignored values are kept as they are in Grafana.
+				Config: testAccDashboardIgnoreJSONPaths(uid, "Ignore JSON Paths Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "config_json", fmt.Sprintf(`{"panels":[{"pluginVersion":"10.0.0","title":"Panel","type":"text"}],"time":{"from":"now-6h","to":"now"},"title":"Ignore JSON Paths Updated","uid":"%s"}`, uid)),
+					func(s *terraform.State) error {
+						model := dashboard.Dashboard.(map[string]interface{})
+						if from := model["time"].(map[string]interface{})["from"]; from != "now-24h" {
+							return fmt.Errorf("expected the time range to be kept, got from=%v", from)
+						}
+						if version := model["panels"].([]interface{})[0].(map[string]interface{})["pluginVersion"]; version != "11.0.0" {
+							return fmt.Errorf("expected the plugin version to be kept, got %v", version)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboard_validateConfigJSON(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	uid := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardValidateConfigJSON(uid, `[
+					{ id = 1, type = "unknown-panel", gridPos = { x = 0, y = 0, w = 12, h = 8 } },
+					{ id = 1, type = "text", gridPos = { x = 6, y = 4, w = 20, h = 8 } },
+					{ id = 2, type = "timeseries", gridPos = { x = 0, y = 20, w = 12, h = 8 }, datasource = { type = "prometheus", uid = "does-not-exist" } },
+				]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)config_json is not a valid dashboard.*duplicate panel ID 1.*exceeds the grid width.*overlaps.*unknown panel type "unknown-panel".*data source "does-not-exist" doesn't exist`),
+			},
+			// A data source created in the same apply is checked once its generated UID is known
+			{
+				Config: testAccDashboardValidateConfigJSON(uid, `[
+					{ id = 1, type = "text", gridPos = { x = 0, y = 0, w = 12, h = 8 } },
+					{ id = 2, type = "timeseries", gridPos = { x = 12, y = 0, w = 12, h = 8 }, datasource = { type = "prometheus", uid = "$${datasource}" } },
+					{ id = 3, type = "timeseries", gridPos = { x = 0, y = 8, w = 12, h = 8 }, datasource = { type = "prometheus", uid = grafana_data_source.test.uid } },
+				]`) + fmt.Sprintf(`
+resource "grafana_data_source" "test" {
+	name = "%[1]s"
+	type = "prometheus"
+	url  = "http://localhost:9090"
+}`, uid),
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard.test", "validate_config_json", "true"),
+				),
+			},
+			{
+				ImportState:             true,
+				ResourceName:            "grafana_dashboard.test",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_config_json"},
+			},
+		},
+	})
+}
+
 func testAccDashboardCheckExistsInFolder(dashboard *models.DashboardFullWithMeta, folder *models.Folder) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if dashboard.Meta.FolderUID != folder.UID && folder.UID != "" {
@@ -336,6 +437,32 @@ resource "grafana_dashboard" "test_folder" {
 }`, uid, folderRef)
 }
 
+func testAccDashboardIgnoreJSONPaths(uid, title string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	ignore_json_paths = ["$.time", "/panels/*/pluginVersion"]
+	config_json = jsonencode({
+		title  = "%[2]s"
+		uid    = "%[1]s"
+		time   = { from = "now-6h", to = "now" }
+		panels = [{ title = "Panel", type = "text", pluginVersion = "10.0.0" }]
+	})
+}`, uid, title)
+}
+
+func testAccDashboardValidateConfigJSON(uid, panels string) string {
+	return fmt.Sprintf(`
+resource "grafana_dashboard" "test" {
+	validate_config_json = true
+	config_json = jsonencode({
+		title         = "%[1]s"
+		uid           = "%[1]s"
+		schemaVersion = 39
+		panels        = %[2]s
+	})
+}`, uid, panels)
+}
+
 func testAccDashboardInOrganization(orgName string) string {
 	return fmt.Sprintf(`
 resource "grafana_organization" "test" {
@@ -0,0 +1,262 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// dashboardGridWidth is the number of columns of the dashboard grid.
+	dashboardGridWidth = 24
+	// dashboardGridPosSchemaVersion is the schema version from which panels are positioned with `gridPos` instead of being nested in `rows`.
+	dashboardGridPosSchemaVersion = 16
+)
+
+// dashboardBuiltinDatasourceUIDs are data source references which don't match a data source of the org.
+var dashboardBuiltinDatasourceUIDs = map[string]bool{
+	"grafana":         true,
+	"-- Grafana --":   true,
+	"-- Mixed --":     true,
+	"-- Dashboard --": true,
+	"default":         true,
+}
+
+// dashboardValidationPanel is a panel found in a dashboard model, with a description used in diagnostics.
+type dashboardValidationPanel struct {
+	model       map[string]interface{}
+	description string
+	// nested is set for panels within a row (collapsed rows, or rows of schema versions before gridPos)
+	nested bool
+}
+
+// validateDashboardCustomizeDiff is the CustomizeDiff of dashboards. When `validate_config_json` is set,
+// it validates the structure of `config_json` at plan time, and returns all the problems found.
+// Data sources created in the same apply are referenced through their attributes, which leaves `config_json` unknown until they exist.
+func validateDashboardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_config_json").(bool) || (d.Id() != "" && !d.HasChanges("config_json", "validate_config_json")) {
+		return nil
+	}
+
+	// The raw config is used, since the planned value may be a SHA256 hash
+	configJSON := d.GetRawConfig().GetAttr("config_json")
+	if !configJSON.IsKnown() || configJSON.IsNull() {
+		return nil
+	}
+	model, err := UnmarshalDashboardConfigJSON(configJSON.AsString())
+	if err != nil {
+		return nil // Reported by validateDashboardConfigJSON
+	}
+
+	problems := validateDashboardModel(model)
+
+	// Panel types and data source references are checked against the Grafana instance, when the org is known.
+	// Without a Grafana client, the provider reports the missing configuration when the resource is applied.
+	if d.NewValueKnown("org_id") && meta.(*common.Client).GrafanaAPI != nil {
+		client := meta.(*common.Client).GrafanaAPI.Clone()
+		if orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64); orgID > 0 {
+			client = client.WithOrgID(orgID)
+		}
+
+		var plugins []struct {
+			ID string `json:"id"`
+		}
+		if err := grafanaAPIRequest(ctx, client, "GET", "/plugins?type=panel", nil, &plugins); err != nil {
+			return fmt.Errorf("failed to list panel plugins to validate the dashboard: %w", err)
+		}
+		panelTypes := map[string]bool{"row": true}
+		for _, plugin := range plugins {
+			panelTypes[plugin.ID] = true
+		}
+		for _, panel := range dashboardValidationPanels(model) {
+			if panelType, ok := panel.model["type"].(string); ok && !panelTypes[panelType] {
+				problems = append(problems, fmt.Sprintf("%s: unknown panel type %q", panel.description, panelType))
+			}
+		}
+
+		for _, ref := range dashboardDatasourceRefs(model) {
+			var err error
+			if ref.byName {
+				_, err = client.Datasources.GetDataSourceByName(ref.value)
+			} else {
+				_, err = client.Datasources.GetDataSourceByUID(ref.value)
+			}
+			if common.IsNotFoundError(err) {
+				problems = append(problems, fmt.Sprintf("%s: data source %q doesn't exist", ref.description, ref.value))
+			} else if err != nil {
+				return fmt.Errorf("failed to get data source %q to validate the dashboard: %w", ref.value, err)
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("config_json is not a valid dashboard:\n  - %s", strings.Join(problems, "\n  - "))
+}
+
+// validateDashboardModel checks the structure of a dashboard model, without calling Grafana:
+// missing panel types, duplicate panel IDs and invalid or overlapping `gridPos`.
+func validateDashboardModel(model map[string]interface{}) []string {
+	var problems []string
+
+	schemaVersion := dashboardGridPosSchemaVersion
+	if v, ok := model["schemaVersion"].(float64); ok {
+		schemaVersion = int(v)
+	}
+	if _, ok := model["rows"]; ok && schemaVersion >= dashboardGridPosSchemaVersion {
+		problems = append(problems, fmt.Sprintf("`rows` are not supported by schema version %d, panels must be set in `panels`", schemaVersion))
+	}
+
+	panels := dashboardValidationPanels(model)
+	ids := map[float64]string{}
+	for _, panel := range panels {
+		if _, ok := panel.model["libraryPanel"]; !ok {
+			if _, ok := panel.model["type"].(string); !ok {
+				problems = append(problems, fmt.Sprintf("%s: missing panel type", panel.description))
+			}
+		}
+		if id, ok := panel.model["id"].(float64); ok {
+			if other, ok := ids[id]; ok {
+				problems = append(problems, fmt.Sprintf("%s: duplicate panel ID %v, already used by %s", panel.description, id, other))
+			} else {
+				ids[id] = panel.description
+			}
+		}
+	}
+
+	if schemaVersion < dashboardGridPosSchemaVersion {
+		return problems
+	}
+
+	// Panels of collapsed rows are positioned as if the row was expanded, so they are only checked against each other
+	type gridRect struct {
+		x, y, w, h  int
+		description string
+	}
+	var topLevel, nested []gridRect
+	for _, panel := range panels {
+		gridPos, ok := panel.model["gridPos"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rect := gridRect{description: panel.description}
+		for key, value := range map[string]*int{"x": &rect.x, "y": &rect.y, "w": &rect.w, "h": &rect.h} {
+			if v, ok := gridPos[key].(float64); ok {
+				*value = int(v)
+			}
+		}
+		switch {
+		case rect.x < 0 || rect.y < 0 || rect.w <= 0 || rect.h <= 0:
+			problems = append(problems, fmt.Sprintf("%s: gridPos must have a positive width and height and a non-negative position", panel.description))
+			continue
+		case rect.x+rect.w > dashboardGridWidth:
+			problems = append(problems, fmt.Sprintf("%s: gridPos exceeds the grid width of %d columns (x=%d, w=%d)", panel.description, dashboardGridWidth, rect.x, rect.w))
+		}
+		if panel.nested {
+			nested = append(nested, rect)
+		} else {
+			topLevel = append(topLevel, rect)
+		}
+	}
+	for _, rects := range [][]gridRect{topLevel, nested} {
+		for i, a := range rects {
+			for _, b := range rects[i+1:] {
+				if a.x < b.x+b.w && b.x < a.x+a.w && a.y < b.y+b.h && b.y < a.y+a.h {
+					problems = append(problems, fmt.Sprintf("%s: gridPos overlaps %s", b.description, a.description))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// dashboardValidationPanels returns the panels of a dashboard model, including the panels of rows.
+func dashboardValidationPanels(model map[string]interface{}) []dashboardValidationPanel {
+	var panels []dashboardValidationPanel
+	var add func(list interface{}, prefix string, nested bool)
+	add = func(list interface{}, prefix string, nested bool) {
+		items, _ := list.([]interface{})
+		for i, item := range items {
+			panelModel, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			description := fmt.Sprintf("%s[%d]", prefix, i)
+			if title, ok := panelModel["title"].(string); ok && title != "" {
+				description += fmt.Sprintf(" (%q)", title)
+			}
+			panels = append(panels, dashboardValidationPanel{model: panelModel, description: description, nested: nested})
+			add(panelModel["panels"], description+".panels", true)
+		}
+	}
+	add(model["panels"], "panels", false)
+
+	rows, _ := model["rows"].([]interface{})
+	for i, row := range rows {
+		if rowModel, ok := row.(map[string]interface{}); ok {
+			add(rowModel["panels"], fmt.Sprintf("rows[%d].panels", i), true)
+		}
+	}
+	return panels
+}
+
+// dashboardDatasourceRef is a reference to a data source, by UID or by name (schema versions before 36).
+type dashboardDatasourceRef struct {
+	value       string
+	byName      bool
+	description string
+}
+
+// dashboardDatasourceRefs returns the data sources referenced by the panels, queries and template variables of a dashboard model.
+// Built-in data sources and references using template variables are skipped, and each data source is returned once.
+func dashboardDatasourceRefs(model map[string]interface{}) []dashboardDatasourceRef {
+	refs := map[string]dashboardDatasourceRef{}
+	addRef := func(datasource interface{}, description string) {
+		ref := dashboardDatasourceRef{description: description}
+		switch v := datasource.(type) {
+		case string:
+			ref.value, ref.byName = v, true
+		case map[string]interface{}:
+			ref.value, _ = v["uid"].(string)
+		}
+		if ref.value == "" || dashboardBuiltinDatasourceUIDs[ref.value] || strings.Contains(ref.value, "$") {
+			return
+		}
+		key := fmt.Sprintf("%t:%s", ref.byName, ref.value)
+		if _, ok := refs[key]; !ok {
+			refs[key] = ref
+		}
+	}
+
+	for _, panel := range dashboardValidationPanels(model) {
+		addRef(panel.model["datasource"], panel.description)
+		targets, _ := panel.model["targets"].([]interface{})
+		for i, target := range targets {
+			if targetModel, ok := target.(map[string]interface{}); ok {
+				addRef(targetModel["datasource"], fmt.Sprintf("%s.targets[%d]", panel.description, i))
+			}
+		}
+	}
+	if templating, ok := model["templating"].(map[string]interface{}); ok {
+		variables, _ := templating["list"].([]interface{})
+		for i, variable := range variables {
+			if variableModel, ok := variable.(map[string]interface{}); ok && variableModel["type"] == "query" {
+				addRef(variableModel["datasource"], fmt.Sprintf("templating.list[%d] (%q)", i, variableModel["name"]))
+			}
+		}
+	}
+
+	result := make([]dashboardDatasourceRef, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, ref)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].description < result[j].description })
+	return result
+}
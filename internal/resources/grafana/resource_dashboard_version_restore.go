@@ -0,0 +1,110 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceDashboardVersionRestoreID = common.NewResourceID(
+	common.OptionalIntIDField("orgID"),
+	common.StringIDField("dashboardUID"),
+	common.IntIDField("version"),
+)
+
+func resourceDashboardVersionRestore() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Restores a dashboard to a previous version. The restore creates a new version of the dashboard, with the content of the given version.
+
+The restore is done when the resource is created, or when ` + "`version`" + ` changes. Destroying the resource doesn't revert the restore.
+If the dashboard is also managed with the ` + "`grafana_dashboard`" + ` resource, its next apply overwrites the restored version.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/build-dashboards/manage-version-history/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard_versions/#restore-dashboard)
+`,
+
+		CreateContext: createDashboardVersionRestore,
+		ReadContext:   readDashboardVersionRestore,
+		DeleteContext: schema.NoopContext,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"dashboard_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the dashboard to restore.",
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The version to restore. See the `grafana_dashboard_versions` data source to list the versions.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"restored_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the dashboard created by the restore.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_dashboard_version_restore",
+		nil, // Not importable, the restore is an action
+		schema,
+	)
+}
+
+func createDashboardVersionRestore(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	uid := d.Get("dashboard_uid").(string)
+	version := int64(d.Get("version").(int))
+
+	if _, err := client.DashboardVersions.RestoreDashboardVersionByUID(uid, &models.RestoreDashboardVersionCommand{Version: version}); err != nil {
+		return diag.Errorf("failed to restore version %d of dashboard %q: %s", version, uid, err)
+	}
+
+	// The restore response doesn't include the new version
+	resp, err := client.Dashboards.GetDashboardByUID(uid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	model := resp.Payload.Dashboard.(map[string]interface{})
+
+	d.SetId(resourceDashboardVersionRestoreID.Make(orgID, uid, version))
+	d.Set("restored_version", int64(model["version"].(float64)))
+	return readDashboardVersionRestore(ctx, d, meta)
+}
+
+func readDashboardVersionRestore(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, idWithoutOrg := OAPIClientFromExistingOrgResource(meta, d.Id())
+	uid, versionStr, found := strings.Cut(idWithoutOrg, common.ResourceIDSeparator)
+	if !found {
+		return diag.Errorf("invalid ID %q", idWithoutOrg)
+	}
+	version, err := strconv.ParseInt(versionStr, 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid version in ID %q: %s", idWithoutOrg, err)
+	}
+
+	// The restore is a one-time action. Only check that the dashboard still exists.
+	_, err = client.Dashboards.GetDashboardByUID(uid)
+	if err, shouldReturn := common.CheckReadError("dashboard", d, err); shouldReturn {
+		return err
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("dashboard_uid", uid)
+	d.Set("version", version)
+	return nil
+}
@@ -0,0 +1,62 @@
+package grafana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDashboardVersionRestore_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dashboard models.DashboardFullWithMeta
+	name := acctest.RandString(10)
+
+	dashboardConfig := func(title string) string {
+		return fmt.Sprintf(`
+		resource "grafana_dashboard" "test" {
+			config_json = jsonencode({
+				title = "%s"
+			})
+		}`, title)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             dashboardCheckExists.destroyed(&dashboard, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardConfig(name),
+			},
+			{
+				Config: dashboardConfig(name + "-broken"),
+				Check:  dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+			},
+			// Restoring creates a new version with the content of the first one.
+			// The plan isn't empty since the dashboard resource has the content of the second version.
+			{
+				Config: dashboardConfig(name+"-broken") + `
+				resource "grafana_dashboard_version_restore" "test" {
+					dashboard_uid = grafana_dashboard.test.uid
+					version       = 1
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.exists("grafana_dashboard.test", &dashboard),
+					resource.TestCheckResourceAttr("grafana_dashboard_version_restore.test", "restored_version", "3"),
+					func(s *terraform.State) error {
+						if title := dashboard.Dashboard.(map[string]interface{})["title"]; title != name {
+							return fmt.Errorf("expected the dashboard to be restored to %q, got %q", name, title)
+						}
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,401 @@
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDashboards() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages a set of Grafana dashboards in a folder, from a directory of JSON files or a map of JSON documents.
+
+Dashboards are synced as a set: new documents are created, changed documents are updated and dashboards whose document was removed are deleted.
+Only a SHA256 hash of each dashboard is stored in the state, and dashboards are saved concurrently.
+
+Each document must set a ` + "`uid`" + `, which identifies its dashboard in Grafana.
+
+When some dashboards can't be saved while the resource is created, they are reported as warnings instead of errors and saved on the next apply.
+An error would taint the resource, and its replacement would delete the dashboards which were saved.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard/)
+`,
+
+		CreateContext: createDashboards,
+		ReadContext:   readDashboards,
+		UpdateContext: syncDashboards,
+		DeleteContext: deleteDashboards,
+		CustomizeDiff: diffDashboards,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the set of dashboards. It identifies the resource, and must be unique in the organization.",
+			},
+			"folder": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The UID of the folder to save the dashboards in. Defaults to the root of the instance.",
+			},
+			"directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The path of a directory containing the dashboards, one `.json` file per dashboard. Subdirectories are ignored. The key of each dashboard is its file name, without the extension.",
+				ExactlyOneOf: []string{"directory", "config_json"},
+			},
+			"config_json": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The dashboard model JSON documents, by key.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to overwrite dashboards which already exist in Grafana with the same UID when they are added to the set.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Set a commit message for the version history.",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				Description:  "The maximum number of dashboards saved or deleted at the same time.",
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"uids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The UIDs of the dashboards, by key.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"dashboard_sha256": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The SHA256 hashes of the dashboards, by key. A dashboard changed or moved in Grafana has a different hash, and is updated on the next apply.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_dashboards",
+		nil, // Not importable, the set of dashboards is only known from the configuration
+		schema,
+	)
+}
+
+// dashboardsDocument is a dashboard of a `grafana_dashboards` set.
+type dashboardsDocument struct {
+	uid   string
+	model map[string]interface{}
+	hash  string
+}
+
+// loadDashboardsDocuments returns the dashboards configured in `directory` or `config_json`, by key.
+func loadDashboardsDocuments(directory string, configJSON map[string]interface{}) (map[string]dashboardsDocument, error) {
+	documents := map[string]string{}
+	if directory != "" {
+		entries, err := os.ReadDir(directory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dashboards directory: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(directory, entry.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read dashboard file: %w", err)
+			}
+			documents[strings.TrimSuffix(entry.Name(), ".json")] = string(content)
+		}
+	}
+	for key, document := range configJSON {
+		documents[key] = document.(string)
+	}
+
+	result := map[string]dashboardsDocument{}
+	keysByUID := map[string]string{}
+	for key, document := range documents {
+		model, err := UnmarshalDashboardConfigJSON(document)
+		if err != nil {
+			return nil, fmt.Errorf("dashboard %q is not valid JSON: %w", key, err)
+		}
+		uid, _ := model["uid"].(string)
+		if uid == "" {
+			return nil, fmt.Errorf("dashboard %q must set a uid", key)
+		}
+		if other, ok := keysByUID[uid]; ok {
+			return nil, fmt.Errorf("dashboards %q and %q have the same uid %q", other, key, uid)
+		}
+		keysByUID[uid] = key
+		delete(model, "id")
+		result[key] = dashboardsDocument{uid: uid, model: model, hash: dashboardsModelHash(model)}
+	}
+	return result, nil
+}
+
+// dashboardsModelHash returns the hash of a normalized dashboard model. The model is modified.
+func dashboardsModelHash(model map[string]interface{}) string {
+	hash := sha256.Sum256([]byte(NormalizeDashboardConfigJSON(model)))
+	return fmt.Sprintf("%x", hash[:])
+}
+
+// diffDashboards is the CustomizeDiff of `grafana_dashboards`. It computes the hashes of the configured dashboards,
+// so that changes in the directory and changes made in Grafana show in the plan.
+func diffDashboards(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("directory") || !d.NewValueKnown("config_json") {
+		if err := d.SetNewComputed("uids"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("dashboard_sha256")
+	}
+
+	documents, err := loadDashboardsDocuments(d.Get("directory").(string), d.Get("config_json").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	uids := map[string]interface{}{}
+	hashes := map[string]interface{}{}
+	for key, document := range documents {
+		uids[key] = document.uid
+		hashes[key] = document.hash
+	}
+
+	if !reflect.DeepEqual(d.Get("uids"), uids) {
+		if err := d.SetNew("uids", uids); err != nil {
+			return err
+		}
+	}
+	if !reflect.DeepEqual(d.Get("dashboard_sha256"), hashes) {
+		return d.SetNew("dashboard_sha256", hashes)
+	}
+	return nil
+}
+
+func createDashboards(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := syncDashboards(ctx, d, meta)
+	if d.Id() == "" {
+		return diags
+	}
+	// The dashboards which couldn't be saved are missing from the state, so they are saved on the next apply
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+	return diags
+}
+
+func syncDashboards(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	folder := d.Get("folder").(string)
+
+	documents, err := loadDashboardsDocuments(d.Get("directory").(string), d.Get("config_json").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldUIDs, _ := d.GetChange("uids")
+	oldHashes, _ := d.GetChange("dashboard_sha256")
+	previousUIDs := common.UnpackMap[string](oldUIDs)
+	uids := common.UnpackMap[string](oldUIDs)
+	hashes := common.UnpackMap[string](oldHashes)
+	managedUIDs := map[string]bool{}
+	for _, uid := range previousUIDs {
+		managedUIDs[uid] = true
+	}
+
+	// Dashboards are saved when they are new or changed, or when they must be moved to another folder
+	var toSave []string
+	for key, document := range documents {
+		if d.HasChange("folder") || hashes[key] != document.hash || uids[key] != document.uid {
+			toSave = append(toSave, key)
+		}
+	}
+	sort.Strings(toSave)
+	saveErrors := forEachDashboardConcurrently(toSave, d.Get("concurrency").(int), func(key string) error {
+		document := documents[key]
+		_, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
+			Dashboard: document.model,
+			FolderUID: folder,
+			Message:   d.Get("message").(string),
+			Overwrite: managedUIDs[document.uid] || d.Get("overwrite").(bool),
+		})
+		return err
+	})
+
+	var diags diag.Diagnostics
+	for _, key := range toSave {
+		if err := saveErrors[key]; err != nil {
+			diags = append(diags, diag.Errorf("failed to save dashboard %q (uid %s): %s", key, documents[key].uid, err)...)
+			continue
+		}
+		uids[key] = documents[key].uid
+		hashes[key] = documents[key].hash
+	}
+
+	// Dashboards are deleted when their document was removed or their UID changed, unless another document uses their UID
+	newUIDs := map[string]bool{}
+	for _, document := range documents {
+		newUIDs[document.uid] = true
+	}
+	var toDelete []string
+	for key, uid := range previousUIDs {
+		if !newUIDs[uid] {
+			toDelete = append(toDelete, key)
+		}
+	}
+	sort.Strings(toDelete)
+	deleteErrors := forEachDashboardConcurrently(toDelete, d.Get("concurrency").(int), func(key string) error {
+		_, err := client.Dashboards.DeleteDashboardByUID(previousUIDs[key])
+		if common.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	})
+	for _, key := range toDelete {
+		if err := deleteErrors[key]; err != nil {
+			diags = append(diags, diag.Errorf("failed to delete dashboard %q (uid %s): %s", key, previousUIDs[key], err)...)
+		}
+	}
+
+	// Removed or renamed documents are dropped from the state, even when their UID is still used by another document.
+	// Those whose dashboard couldn't be deleted are kept, so that the deletion is retried.
+	for key := range uids {
+		if _, ok := documents[key]; !ok && deleteErrors[key] == nil {
+			delete(uids, key)
+			delete(hashes, key)
+		}
+	}
+
+	d.SetId(MakeOrgResourceID(orgID, d.Get("name").(string)))
+	d.Set("uids", uids)
+	d.Set("dashboard_sha256", hashes)
+	if diags.HasError() {
+		return diags
+	}
+	return readDashboards(ctx, d, meta)
+}
+
+func readDashboards(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+	folder := d.Get("folder").(string)
+
+	uids := common.UnpackMap[string](d.Get("uids"))
+	keys := make([]string, 0, len(uids))
+	for key := range uids {
+		keys = append(keys, key)
+	}
+
+	var mu sync.Mutex
+	hashes := common.UnpackMap[string](d.Get("dashboard_sha256"))
+	readErrors := forEachDashboardConcurrently(keys, d.Get("concurrency").(int), func(key string) error {
+		resp, err := client.Dashboards.GetDashboardByUID(uids[key])
+		mu.Lock()
+		defer mu.Unlock()
+		if common.IsNotFoundError(err) {
+			// Deleted in Grafana, it's created again on the next apply
+			delete(hashes, key)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		model, ok := resp.Payload.Dashboard.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected dashboard model of type %T", resp.Payload.Dashboard)
+		}
+		hashes[key] = dashboardsModelHash(model)
+		if resp.Payload.Meta.FolderUID != folder {
+			// Moved in Grafana, it's moved back on the next apply
+			hashes[key] = ""
+		}
+		return nil
+	})
+	for _, key := range keys {
+		if err := readErrors[key]; err != nil {
+			return diag.Errorf("failed to get dashboard %q (uid %s): %s", key, uids[key], err)
+		}
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("dashboard_sha256", hashes)
+	return nil
+}
+
+func deleteDashboards(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	uids := common.UnpackMap[string](d.Get("uids"))
+	keys := make([]string, 0, len(uids))
+	for key := range uids {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	deleteErrors := forEachDashboardConcurrently(keys, d.Get("concurrency").(int), func(key string) error {
+		_, err := client.Dashboards.DeleteDashboardByUID(uids[key])
+		if common.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	})
+	var diags diag.Diagnostics
+	for _, key := range keys {
+		if err := deleteErrors[key]; err != nil {
+			diags = append(diags, diag.Errorf("failed to delete dashboard %q (uid %s): %s", key, uids[key], err)...)
+		}
+	}
+	return diags
+}
+
+// forEachDashboardConcurrently calls fn for each key, with at most `concurrency` calls at the same time, and returns the errors by key.
+func forEachDashboardConcurrently(keys []string, concurrency int, fn func(key string) error) map[string]error {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   = map[string]error{}
+		tokens = make(chan struct{}, max(concurrency, 1))
+	)
+	for _, key := range keys {
+		wg.Add(1)
+		tokens <- struct{}{}
+		go func(key string) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+			if err := fn(key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}(key)
+	}
+	wg.Wait()
+	return errs
+}
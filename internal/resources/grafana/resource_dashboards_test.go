@@ -0,0 +1,207 @@
+package grafana_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDashboards_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var folder models.Folder
+	prefix := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			folderCheckExists.destroyed(&folder, nil),
+			testAccDashboardsCheckDestroy(prefix+"-api", prefix+"-workers", prefix+"-jobs"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_dashboards/resource.tf", map[string]string{
+					`"Services"`: `"` + prefix + `"`,
+					"services-":  prefix + "-",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.services", &folder),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "id", "1:services"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.%", "2"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.api", prefix+"-api"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.workers", prefix+"-workers"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "dashboard_sha256.%", "2"),
+					testAccDashboardsCheckTitle(prefix+"-api", "API", &folder),
+					testAccDashboardsCheckTitle(prefix+"-workers", "Workers", &folder),
+				),
+			},
+			// A dashboard changed in Grafana is reverted
+			{
+				PreConfig: func() {
+					client := grafanaTestClient()
+					if _, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
+						Dashboard: map[string]interface{}{"uid": prefix + "-api", "title": "Changed"},
+						FolderUID: folder.UID,
+						Overwrite: true,
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_dashboards/resource.tf", map[string]string{
+					`"Services"`: `"` + prefix + `"`,
+					"services-":  prefix + "-",
+				}),
+				Check: testAccDashboardsCheckTitle(prefix+"-api", "API", &folder),
+			},
+			// Update one dashboard, remove one and add one
+			{
+				Config: testAccDashboardsConfig(prefix, `
+					api  = jsonencode({ uid = "`+prefix+`-api", title = "API v2" })
+					jobs = jsonencode({ uid = "`+prefix+`-jobs", title = "Jobs" })
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.%", "2"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.jobs", prefix+"-jobs"),
+					testAccDashboardsCheckTitle(prefix+"-api", "API v2", &folder),
+					testAccDashboardsCheckTitle(prefix+"-jobs", "Jobs", &folder),
+					testAccDashboardsCheckDestroy(prefix+"-workers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboards_directory(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var folder models.Folder
+	prefix := acctest.RandString(10)
+	directory := t.TempDir()
+	writeDashboard := func(name, uid, title string) {
+		content := fmt.Sprintf(`{"uid": %q, "title": %q}`, uid, title)
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeDashboard("api.json", prefix+"-api", "API")
+	writeDashboard("workers.json", prefix+"-workers", "Workers")
+	writeDashboard("README.md", "", "")
+
+	config := fmt.Sprintf(`
+resource "grafana_folder" "services" {
+  title = "%[1]s"
+}
+
+resource "grafana_dashboards" "services" {
+  name      = "%[1]s"
+  folder    = grafana_folder.services.uid
+  directory = "%[2]s"
+}
+`, prefix, filepath.ToSlash(directory))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			folderCheckExists.destroyed(&folder, nil),
+			testAccDashboardsCheckDestroy(prefix+"-api", prefix+"-workers"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.services", &folder),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.%", "2"),
+					testAccDashboardsCheckTitle(prefix+"-api", "API", &folder),
+					testAccDashboardsCheckTitle(prefix+"-workers", "Workers", &folder),
+				),
+			},
+			// Changes of the files are applied
+			{
+				PreConfig: func() {
+					writeDashboard("api.json", prefix+"-api", "API v2")
+					if err := os.Remove(filepath.Join(directory, "workers.json")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.%", "1"),
+					testAccDashboardsCheckTitle(prefix+"-api", "API v2", &folder),
+					testAccDashboardsCheckDestroy(prefix+"-workers"),
+				),
+			},
+			// Renaming a file keeps its dashboard and replaces its key
+			{
+				PreConfig: func() {
+					if err := os.Rename(filepath.Join(directory, "api.json"), filepath.Join(directory, "gateway.json")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.%", "1"),
+					resource.TestCheckResourceAttr("grafana_dashboards.services", "uids.gateway", prefix+"-api"),
+					resource.TestCheckNoResourceAttr("grafana_dashboards.services", "uids.api"),
+					resource.TestCheckNoResourceAttr("grafana_dashboards.services", "dashboard_sha256.api"),
+					testAccDashboardsCheckTitle(prefix+"-api", "API v2", &folder),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardsConfig(prefix, configJSON string) string {
+	return fmt.Sprintf(`
+resource "grafana_folder" "services" {
+  title = "%[1]s"
+}
+
+resource "grafana_dashboards" "services" {
+  name   = "%[1]s"
+  folder = grafana_folder.services.uid
+
+  config_json = {
+    %[2]s
+  }
+}
+`, prefix, configJSON)
+}
+
+func testAccDashboardsCheckTitle(uid, title string, folder *models.Folder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := grafanaTestClient().Dashboards.GetDashboardByUID(uid)
+		if err != nil {
+			return err
+		}
+		if got := resp.Payload.Dashboard.(map[string]interface{})["title"]; got != title {
+			return fmt.Errorf("expected dashboard %s to have title %q, got %q", uid, title, got)
+		}
+		if resp.Payload.Meta.FolderUID != folder.UID {
+			return fmt.Errorf("expected dashboard %s to be in folder %s, got %s", uid, folder.UID, resp.Payload.Meta.FolderUID)
+		}
+		return nil
+	}
+}
+
+func testAccDashboardsCheckDestroy(uids ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := grafanaTestClient()
+		for _, uid := range uids {
+			_, err := client.Dashboards.GetDashboardByUID(uid)
+			if err == nil {
+				return fmt.Errorf("dashboard %s still exists", uid)
+			} else if !common.IsNotFoundError(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
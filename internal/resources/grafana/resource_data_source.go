@@ -33,6 +33,7 @@ source selected (via the 'type' argument).
 		UpdateContext: UpdateDataSource,
 		DeleteContext: DeleteDataSource,
 		ReadContext:   ReadDataSource,
+		CustomizeDiff: validateDataSourceCustomizeDiff,
 		SchemaVersion: 1,
 
 		Importer: &schema.ResourceImporter{
@@ -48,6 +49,8 @@ source selected (via the 'type' argument).
 					return nil, fmt.Errorf("this Grafana data source is read-only. It cannot be imported as a resource. Use the `data_grafana_data_source` data source instead")
 				}
 
+				importDatasourceTypedConfig(d, resp.Payload)
+
 				return schema.ImportStatePassthroughContext(ctx, d, meta)
 			},
 		},
@@ -119,8 +122,18 @@ source selected (via the 'type' argument).
 			},
 			"json_data_encoded":        datasourceJSONDataAttribute(),
 			"secure_json_data_encoded": datasourceSecureJSONDataAttribute(),
+			"health_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      datasourceHealthCheckOff,
+				Description:  common.AllowedValuesDescription("Whether to run the health check of the data source after it's created or updated. With `warn`, a failed check is reported as a warning. With `error`, it fails the apply", datasourceHealthCheckModes),
+				ValidateFunc: validation.StringInSlice(datasourceHealthCheckModes, false),
+			},
 		},
 	}
+	for k, v := range datasourceTypedConfigAttributes() {
+		schema.Schema[k] = v
+	}
 
 	return common.NewLegacySDKResource(
 		common.CategoryGrafanaOSS,
@@ -202,6 +215,37 @@ func datasourceSecureJSONDataAttribute() *schema.Schema {
 	}
 }
 
+// runDatasourceHealthCheck checks the health of the data source if enabled by `health_check`, and reports failures with the plugin's message.
+func runDatasourceHealthCheck(ctx context.Context, d *schema.ResourceData, client *goapi.GrafanaHTTPAPI) diag.Diagnostics {
+	mode := d.Get("health_check").(string)
+	if mode == datasourceHealthCheckOff {
+		return nil
+	}
+
+	severity := diag.Error
+	if mode == datasourceHealthCheckWarn {
+		severity = diag.Warning
+	}
+
+	uid := d.Get("uid").(string)
+	health, err := getDatasourceHealth(ctx, client, uid)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: severity,
+			Summary:  fmt.Sprintf("failed to run the health check of data source %q", uid),
+			Detail:   err.Error(),
+		}}
+	}
+	if health.Status != datasourceHealthStatusOK {
+		return diag.Diagnostics{{
+			Severity: severity,
+			Summary:  fmt.Sprintf("health check of data source %q failed with status %s", uid, health.Status),
+			Detail:   health.Message,
+		}}
+	}
+	return nil
+}
+
 func listDatasources(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
 	var ids []string
 	resp, err := client.Datasources.GetDataSources()
@@ -234,7 +278,11 @@ func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(MakeOrgResourceID(orgID, resp.Payload.Datasource.UID))
-	return ReadDataSource(ctx, d, meta)
+	diags := ReadDataSource(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, runDatasourceHealthCheck(ctx, d, client)...)
 }
 
 // UpdateDataSource updates a Grafana datasource
@@ -261,8 +309,11 @@ func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 		WithCredentials: dataSource.WithCredentials,
 	}
 	_, err = client.Datasources.UpdateDataSourceByUID(idStr, &body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return runDatasourceHealthCheck(ctx, d, client)
 }
 
 // ReadDataSource reads a Grafana datasource
@@ -274,6 +325,11 @@ func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	// The health check setting is not stored in Grafana. Set the default when importing to avoid a diff on the next plan.
+	if _, ok := d.GetOk("health_check"); !ok {
+		d.Set("health_check", datasourceHealthCheckOff)
+	}
+
 	return datasourceToState(d, resp.Payload)
 }
 
@@ -306,6 +362,7 @@ func datasourceToState(d *schema.ResourceData, dataSource *models.DataSource) di
 
 func datasourceConfigToState(d *schema.ResourceData, dataSource *models.DataSource) diag.Diagnostics {
 	gottenJSONData, gottenHeaders := removeHeadersFromJSONData(dataSource.JSONData.(map[string]interface{}))
+	datasourceTypedConfigsToState(d, gottenJSONData)
 	encodedJSONData, err := json.Marshal(gottenJSONData)
 	if err != nil {
 		return diag.Errorf("Failed to marshal JSON data: %s", err)
@@ -326,7 +383,19 @@ func datasourceConfigToState(d *schema.ResourceData, dataSource *models.DataSour
 	return nil
 }
 
+func validateDataSourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("type") {
+		if err := validateDatasourceTypedConfigs(d, d.Get("type").(string)); err != nil {
+			return err
+		}
+	}
+	return diffDatasourceTypedConfigs(d)
+}
+
 func stateToDatasource(d *schema.ResourceData) (*models.AddDataSourceCommand, error) {
+	if err := validateDatasourceTypedConfigs(d, d.Get("type").(string)); err != nil {
+		return nil, err
+	}
 	jd, sd, err := stateToDatasourceConfig(d)
 	if err != nil {
 		return nil, err
@@ -363,12 +432,15 @@ func stateToDatasourceConfig(d *schema.ResourceData) (map[string]interface{}, ma
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := packDatasourceTypedConfigs(d, jd, sd); err != nil {
+		return nil, nil, err
+	}
 
 	jd, sd = jsonDataWithHeaders(jd, sd, httpHeaders)
 	return jd, sd, nil
 }
 
-func makeJSONData(d *schema.ResourceData) (map[string]interface{}, error) {
+func makeJSONData(d datasourceConfigGetter) (map[string]interface{}, error) {
 	jd := make(map[string]interface{})
 	data := d.Get("json_data_encoded")
 	if data != "" {
@@ -379,7 +451,7 @@ func makeJSONData(d *schema.ResourceData) (map[string]interface{}, error) {
 	return jd, nil
 }
 
-func makeSecureJSONData(d *schema.ResourceData) (map[string]string, error) {
+func makeSecureJSONData(d datasourceConfigGetter) (map[string]string, error) {
 	sjd := make(map[string]string)
 	data := d.Get("secure_json_data_encoded")
 	if data != "" {
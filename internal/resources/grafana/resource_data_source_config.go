@@ -2,6 +2,7 @@ package grafana
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,8 +26,19 @@ func resourceDataSourceConfig() *common.Resource {
 		UpdateContext: UpdateDataSourceConfig,
 		ReadContext:   ReadDataSourceConfig,
 		DeleteContext: DeleteDataSourceConfig,
+		CustomizeDiff: validateDataSourceConfigCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+				resp, err := client.Datasources.GetDataSourceByUID(idStr)
+				if err != nil {
+					return nil, err
+				}
+				importDatasourceTypedConfig(d, resp.Payload)
+
+				return schema.ImportStatePassthroughContext(ctx, d, meta)
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +55,9 @@ func resourceDataSourceConfig() *common.Resource {
 			"secure_json_data_encoded": datasourceSecureJSONDataAttribute(),
 		},
 	}
+	for k, v := range datasourceTypedConfigAttributes() {
+		schema.Schema[k] = v
+	}
 
 	return common.NewLegacySDKResource(
 		common.CategoryGrafanaOSS,
@@ -76,9 +91,40 @@ func ReadDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta inte
 func DeleteDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
 	d.Set("json_data_encoded", "")
+	for _, c := range datasourceTypedConfigs {
+		d.Set(c.attr, nil)
+	}
 	return updateGrafanaDataSourceConfig(d, idStr, client)
 }
 
+func validateDataSourceConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := diffDatasourceTypedConfigs(d); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("uid") || !d.NewValueKnown("org_id") || d.Get("uid").(string) == "" {
+		return nil
+	}
+
+	// The type of the data source is needed to check the typed config blocks.
+	// Without a Grafana client, the provider reports the missing configuration when the resource is applied.
+	if meta.(*common.Client).GrafanaAPI == nil {
+		return nil
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone()
+	if orgID, _ := strconv.ParseInt(d.Get("org_id").(string), 10, 64); orgID > 0 {
+		client = client.WithOrgID(orgID)
+	}
+	resp, err := client.Datasources.GetDataSourceByUID(d.Get("uid").(string))
+	if common.IsNotFoundError(err) {
+		// The data source is created in the same apply, the blocks are checked then
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get data source %s: %w", d.Get("uid").(string), err)
+	}
+	return validateDatasourceTypedConfigs(d, resp.Payload.Type)
+}
+
 func updateGrafanaDataSourceConfig(d *schema.ResourceData, dataSourceUID string, client *goapi.GrafanaHTTPAPI) diag.Diagnostics {
 	resp, err := client.Datasources.GetDataSourceByUID(dataSourceUID)
 	if err != nil {
@@ -87,6 +133,9 @@ func updateGrafanaDataSourceConfig(d *schema.ResourceData, dataSourceUID string,
 	ds := resp.GetPayload()
 	d.SetId(MakeOrgResourceID(ds.OrgID, ds.UID))
 
+	if err := validateDatasourceTypedConfigs(d, ds.Type); err != nil {
+		return diag.FromErr(err)
+	}
 	jd, sd, err := stateToDatasourceConfig(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,324 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/correlations"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceDataSourceCorrelationID = common.NewResourceID(
+	common.OptionalIntIDField("orgID"),
+	common.StringIDField("sourceUID"),
+	common.StringIDField("correlationUID"),
+)
+
+func resourceDataSourceCorrelation() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages correlations between data sources. A correlation links the results of a source data source to a query of a target data source, or to an external URL.
+
+**Note:** This resource is available only with Grafana 10.0+. The ` + "`external`" + ` type requires Grafana 11.0+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/correlations/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/correlations/)
+`,
+
+		CreateContext: createDataSourceCorrelation,
+		ReadContext:   readDataSourceCorrelation,
+		UpdateContext: updateDataSourceCorrelation,
+		DeleteContext: deleteDataSourceCorrelation,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the correlation.",
+			},
+			"source_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the data source whose results are linked.",
+			},
+			"target_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The UID of the data source which is queried. Required when `type` is `query`.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The label of the link shown in the results of the source data source.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the correlation.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "query",
+				Description:  "The type of the correlation. `query` runs a query on the target data source, `external` opens an external URL.",
+				ValidateFunc: validation.StringInSlice([]string{"query", "external"}, false),
+			},
+			"config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The configuration of the link.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The field of the source results on which the link is attached.",
+						},
+						"target_json": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "The target of the link, as JSON. For `query` correlations, it's the query model of the target data source, " +
+								"for `external` correlations, it's an object with the `url`. Variables such as `${field}` are replaced by the values of the source results.",
+							ValidateFunc: validation.StringIsJSON,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+							DiffSuppressFunc: common.SuppressEquivalentJSONDiffs,
+						},
+						"transformation": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Transformations extracting variables from the source results, which can be used in the target.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The type of the transformation. Either `regex` or `logfmt`.",
+										ValidateFunc: validation.StringInSlice([]string{"regex", "logfmt"}, false),
+									},
+									"field": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The field to transform. Defaults to the field of the correlation.",
+									},
+									"expression": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The regular expression of `regex` transformations. The first capture group is used as value.",
+									},
+									"map_value": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The name of the variable holding the value of `regex` transformations. Defaults to the name of the field.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_data_source_correlation",
+		resourceDataSourceCorrelationID,
+		schema,
+	).WithLister(listerFunctionOrgResource(listDataSourceCorrelations))
+}
+
+func listDataSourceCorrelations(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	var ids []string
+	resp, err := client.Datasources.GetDataSources()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ds := range resp.Payload {
+		correlationsResp, err := client.Correlations.GetCorrelationsBySourceUID(ds.UID)
+		if common.IsNotFoundError(err) {
+			continue // Grafana returns a 404 when the data source has no correlations
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, correlation := range correlationsResp.Payload {
+			if correlation.Provisioned {
+				continue
+			}
+			ids = append(ids, resourceDataSourceCorrelationID.Make(orgID, correlation.SourceUID, correlation.UID))
+		}
+	}
+
+	return ids, nil
+}
+
+func createDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	sourceUID := d.Get("source_uid").(string)
+
+	config, err := makeDataSourceCorrelationConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	correlationType := models.CorrelationType(d.Get("type").(string))
+	// The type was moved out of the config in Grafana 11, both are set to support older versions
+	config.Type = correlationType
+	resp, err := client.Correlations.CreateCorrelation(sourceUID, &models.CreateCorrelationCommand{
+		TargetUID:   d.Get("target_uid").(string),
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Type:        correlationType,
+		Config:      config,
+	})
+	if err != nil {
+		return diag.Errorf("failed to create correlation: %s", err)
+	}
+
+	d.SetId(resourceDataSourceCorrelationID.Make(orgID, sourceUID, resp.Payload.Result.UID))
+	return readDataSourceCorrelation(ctx, d, meta)
+}
+
+func readDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, correlationUID, _ := strings.Cut(compositeID, ":")
+
+	resp, err := client.Correlations.GetCorrelation(sourceUID, correlationUID)
+	if err, shouldReturn := common.CheckReadError("correlation", d, err); shouldReturn {
+		return err
+	}
+	correlation := resp.Payload
+
+	d.SetId(resourceDataSourceCorrelationID.Make(orgID, correlation.SourceUID, correlation.UID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("uid", correlation.UID)
+	d.Set("source_uid", correlation.SourceUID)
+	d.Set("target_uid", correlation.TargetUID)
+	d.Set("label", correlation.Label)
+	d.Set("description", correlation.Description)
+
+	correlationType := correlation.Type
+	if correlation.Config != nil && correlationType == "" {
+		correlationType = correlation.Config.Type
+	}
+	if correlationType == "" {
+		correlationType = "query"
+	}
+	d.Set("type", correlationType)
+
+	var config []interface{}
+	if correlation.Config != nil {
+		targetJSON, err := json.Marshal(correlation.Config.Target)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		normalizedTargetJSON, _ := structure.NormalizeJsonString(string(targetJSON))
+
+		transformations := []interface{}{}
+		for _, transformation := range correlation.Config.Transformations {
+			transformations = append(transformations, map[string]interface{}{
+				"type":       transformation.Type,
+				"field":      transformation.Field,
+				"expression": transformation.Expression,
+				"map_value":  transformation.MapValue,
+			})
+		}
+
+		field := ""
+		if correlation.Config.Field != nil {
+			field = *correlation.Config.Field
+		}
+		config = append(config, map[string]interface{}{
+			"field":          field,
+			"target_json":    normalizedTargetJSON,
+			"transformation": transformations,
+		})
+	}
+
+	return diag.FromErr(d.Set("config", config))
+}
+
+func updateDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, correlationUID, _ := strings.Cut(compositeID, ":")
+
+	config, err := makeDataSourceCorrelationConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	params := correlations.NewUpdateCorrelationParams().
+		WithSourceUID(sourceUID).
+		WithCorrelationUID(correlationUID).
+		WithBody(&models.UpdateCorrelationCommand{
+			Label:       d.Get("label").(string),
+			Description: d.Get("description").(string),
+			Type:        models.CorrelationType(d.Get("type").(string)),
+			Config: &models.CorrelationConfigUpdateDTO{
+				Field:           *config.Field,
+				Target:          config.Target,
+				Transformations: config.Transformations,
+			},
+		})
+	if _, err := client.Correlations.UpdateCorrelation(params); err != nil {
+		return diag.Errorf("failed to update correlation: %s", err)
+	}
+
+	return readDataSourceCorrelation(ctx, d, meta)
+}
+
+func deleteDataSourceCorrelation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, compositeID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	sourceUID, correlationUID, _ := strings.Cut(compositeID, ":")
+
+	_, err := client.Correlations.DeleteCorrelation(sourceUID, correlationUID)
+	diag, _ := common.CheckReadError("correlation", d, err)
+	return diag
+}
+
+func makeDataSourceCorrelationConfig(d *schema.ResourceData) (*models.CorrelationConfig, error) {
+	configItems := d.Get("config").([]interface{})
+	if len(configItems) == 0 || configItems[0] == nil {
+		return &models.CorrelationConfig{Field: common.Ref("")}, nil
+	}
+	configItem := configItems[0].(map[string]interface{})
+
+	var target interface{}
+	if err := json.Unmarshal([]byte(configItem["target_json"].(string)), &target); err != nil {
+		return nil, err
+	}
+
+	transformations := models.Transformations{}
+	for _, item := range configItem["transformation"].([]interface{}) {
+		transformation := item.(map[string]interface{})
+		transformations = append(transformations, &models.Transformation{
+			Type:       transformation["type"].(string),
+			Field:      transformation["field"].(string),
+			Expression: transformation["expression"].(string),
+			MapValue:   transformation["map_value"].(string),
+		})
+	}
+
+	return &models.CorrelationConfig{
+		Field:           common.Ref(configItem["field"].(string)),
+		Target:          target,
+		Transformations: transformations,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceCorrelation_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.0.0")
+
+	name := acctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccDataSourceCorrelationCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_data_source_correlation/resource.tf", map[string]string{
+					`name = "logs"`:   `name = "` + name + `-logs"`,
+					`name = "traces"`: `name = "` + name + `-traces"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_data_source_correlation.logs_to_traces", "id", regexp.MustCompile(`^\d+:[a-zA-Z0-9-_]+:[a-zA-Z0-9-_]+$`)),
+					resource.TestCheckResourceAttrSet("grafana_data_source_correlation.logs_to_traces", "uid"),
+					resource.TestCheckResourceAttrPair("grafana_data_source_correlation.logs_to_traces", "source_uid", "grafana_data_source.logs", "uid"),
+					resource.TestCheckResourceAttrPair("grafana_data_source_correlation.logs_to_traces", "target_uid", "grafana_data_source.traces", "uid"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "label", "Open trace"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "type", "query"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.field", "Line"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.target_json", `{"query":"${traceId}","queryType":"traceql"}`),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.0.map_value", "traceId"),
+				),
+			},
+			{
+				ResourceName:      "grafana_data_source_correlation.logs_to_traces",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the label and the transformation
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_data_source_correlation/resource.tf", map[string]string{
+					`name = "logs"`:   `name = "` + name + `-logs"`,
+					`name = "traces"`: `name = "` + name + `-traces"`,
+					`"Open trace"`:    `"Show trace"`,
+					`"regex"`:         `"logfmt"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "label", "Show trace"),
+					resource.TestCheckResourceAttr("grafana_data_source_correlation.logs_to_traces", "config.0.transformation.0.type", "logfmt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCorrelationCheckDestroy(s *terraform.State) error {
+	client := grafanaTestClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "grafana_data_source_correlation" {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, ":")
+		sourceUID, correlationUID := parts[len(parts)-2], parts[len(parts)-1]
+		_, err := client.Correlations.GetCorrelation(sourceUID, correlationUID)
+		if err == nil {
+			return fmt.Errorf("correlation %s still exists", correlationUID)
+		} else if !common.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
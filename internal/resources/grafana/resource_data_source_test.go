@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -95,8 +96,24 @@ func TestAccDataSource_Loki(t *testing.T) {
 				ResourceName:      "grafana_data_source.loki",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// Ignore sensitive attributes, we mostly only care about "json_data_encoded"
-				ImportStateVerifyIgnore: []string{"secure_json_data_encoded", "http_headers."},
+				// Ignore sensitive attributes. The typed settings are imported in the `loki` block, they are checked below
+				ImportStateVerifyIgnore: []string{"secure_json_data_encoded", "http_headers.", "json_data_encoded", "loki"},
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+					// maxLines is a number in the config, it stays in the JSON data since the typed attribute is a string
+					if jsonData := s[0].Attributes["json_data_encoded"]; jsonData != `{"maxLines":2022}` {
+						return fmt.Errorf("bad json_data_encoded: %s", jsonData)
+					}
+					if count := s[0].Attributes["loki.0.derived_fields.#"]; count != "2" {
+						return fmt.Errorf("expected 2 derived fields in the loki block, got %s", count)
+					}
+					if name := s[0].Attributes["loki.0.derived_fields.1.name"]; name != "WithDatasource" {
+						return fmt.Errorf("bad derived field name: %s", name)
+					}
+					return nil
+				},
 			},
 		},
 	})
@@ -458,3 +475,90 @@ resource "grafana_data_source" "test" {
 	url    = "http://localhost:9090"
 }`, orgName)
 }
+
+func TestAccDataSource_TypedConfig(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=v9.0.0")
+
+	var dataSource models.DataSource
+	dsName := acctest.RandString(10)
+
+	config := func(httpMethod string) string {
+		return fmt.Sprintf(`
+		resource "grafana_data_source" "prometheus" {
+			type = "prometheus"
+			name = "%[1]s"
+			url  = "http://acc-test.invalid/"
+
+			json_data_encoded = jsonencode({
+				manageAlerts = false
+			})
+
+			prometheus {
+				http_method     = "%[2]s"
+				prometheus_type = "Mimir"
+				scrape_interval = "30s"
+
+				exemplar_trace_id_destinations {
+					name = "traceID"
+					url  = "http://tempo.invalid/$${__value.raw}"
+				}
+			}
+		}`, dsName, httpMethod)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             datasourceCheckExists.destroyed(&dataSource, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config("POST"),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.prometheus", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "json_data_encoded", `{"manageAlerts":false}`),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "prometheus.0.http_method", "POST"),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "prometheus.0.exemplar_trace_id_destinations.0.name", "traceID"),
+					func(s *terraform.State) error {
+						expected := map[string]interface{}{
+							"manageAlerts":   false,
+							"httpMethod":     "POST",
+							"prometheusType": "Mimir",
+							"timeInterval":   "30s",
+							"exemplarTraceIdDestinations": []interface{}{
+								map[string]interface{}{"name": "traceID", "url": "http://tempo.invalid/${__value.raw}"},
+							},
+						}
+						if !reflect.DeepEqual(dataSource.JSONData, expected) {
+							return fmt.Errorf("bad json data: %#v. Expected: %#v", dataSource.JSONData, expected)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("GET"),
+				Check: resource.ComposeTestCheckFunc(
+					datasourceCheckExists.exists("grafana_data_source.prometheus", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.prometheus", "prometheus.0.http_method", "GET"),
+					func(s *terraform.State) error {
+						if v := dataSource.JSONData.(map[string]interface{})["httpMethod"]; v != "GET" {
+							return fmt.Errorf("bad httpMethod: %v", v)
+						}
+						return nil
+					},
+				),
+			},
+			// The same key can't be set in both places, the plan fails
+			{
+				Config:      strings.Replace(config("GET"), "manageAlerts = false", `httpMethod = "POST"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("httpMethod is also set in `json_data_encoded`"),
+			},
+			// The block must match the data source type, the plan fails
+			{
+				Config:      strings.Replace(config("GET"), `type = "prometheus"`, `type = "loki"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the `prometheus` block can't be used with data sources of type \"loki\""),
+			},
+		},
+	})
+}
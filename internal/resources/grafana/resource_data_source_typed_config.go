@@ -0,0 +1,458 @@
+package grafana
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// datasourceTypedConfig is an optional block which compiles to the jsonData (and secureJsonData) of a given data source type.
+// It's an alternative to `json_data_encoded` for the most common settings, with validation and readable diffs.
+type datasourceTypedConfig struct {
+	attr        string
+	types       []string
+	description string
+	fields      []datasourceConfigField
+}
+
+// datasourceConfigField maps an attribute of a typed config block to a jsonData key.
+// Nested blocks map to a JSON object (with MaxItems 1) or to a list of JSON objects.
+// Zero values are not sent, so they must match Grafana's behavior when the key is absent.
+type datasourceConfigField struct {
+	attr   string
+	key    string
+	schema *schema.Schema
+	fields []datasourceConfigField
+	secure bool
+}
+
+func (f datasourceConfigField) isObject() bool {
+	return f.fields != nil && f.schema.MaxItems == 1
+}
+
+func (f datasourceConfigField) isObjectList() bool {
+	return f.fields != nil && f.schema.MaxItems != 1
+}
+
+func dsConfigString(attr, key, description string, validateFunc schema.SchemaValidateFunc) datasourceConfigField {
+	return datasourceConfigField{attr: attr, key: key, schema: &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  description,
+		ValidateFunc: validateFunc,
+	}}
+}
+
+func dsConfigEnum(attr, key, description string, allowedValues []string) datasourceConfigField {
+	return dsConfigString(attr, key, common.AllowedValuesDescription(description, allowedValues), validation.StringInSlice(allowedValues, false))
+}
+
+func dsConfigDuration(attr, key, description string) datasourceConfigField {
+	f := dsConfigString(attr, key, description+". Ex: `15s`, `1m`", nil)
+	f.schema.ValidateDiagFunc = common.ValidateDurationWithDays
+	return f
+}
+
+func dsConfigSecret(attr, key, description string) datasourceConfigField {
+	f := dsConfigString(attr, key, description+". This value is write-only: Grafana doesn't return it", nil)
+	f.schema.Sensitive = true
+	f.secure = true
+	return f
+}
+
+func dsConfigBool(attr, key, description string) datasourceConfigField {
+	return datasourceConfigField{attr: attr, key: key, schema: &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: description,
+	}}
+}
+
+func dsConfigInt(attr, key, description string) datasourceConfigField {
+	return datasourceConfigField{attr: attr, key: key, schema: &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  description,
+		ValidateFunc: validation.IntAtLeast(0),
+	}}
+}
+
+func dsConfigObject(attr, key, description string, fields ...datasourceConfigField) datasourceConfigField {
+	return datasourceConfigField{attr: attr, key: key, fields: fields, schema: &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+	}}
+}
+
+func dsConfigObjectList(attr, key, description string, fields ...datasourceConfigField) datasourceConfigField {
+	return datasourceConfigField{attr: attr, key: key, fields: fields, schema: &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+	}}
+}
+
+func datasourceConfigFieldsSchema(fields []datasourceConfigField) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(fields))
+	for _, f := range fields {
+		s := *f.schema
+		if f.fields != nil {
+			s.Elem = &schema.Resource{Schema: datasourceConfigFieldsSchema(f.fields)}
+		}
+		result[f.attr] = &s
+	}
+	return result
+}
+
+var (
+	numericStringRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+	datasourceTraceTagsField = dsConfigObjectList("tags", "tags", "Tags of the span to use in the query, optionally renamed.",
+		dsConfigString("key", "key", "The span attribute name.", validation.StringIsNotEmpty),
+		dsConfigString("value", "value", "The name of the label in the query. Defaults to the span attribute name.", nil),
+	)
+
+	datasourceTypedConfigs = []datasourceTypedConfig{
+		{
+			attr:        "prometheus",
+			types:       []string{"prometheus"},
+			description: "Typed settings of Prometheus data sources.",
+			fields: []datasourceConfigField{
+				dsConfigEnum("http_method", "httpMethod", "The HTTP method used to query Prometheus", []string{"GET", "POST"}),
+				dsConfigEnum("prometheus_type", "prometheusType", "The type of Prometheus server", []string{"Prometheus", "Cortex", "Mimir", "Thanos"}),
+				dsConfigString("prometheus_version", "prometheusVersion", "The version of the Prometheus server. Ex: `2.50.0`.", nil),
+				dsConfigDuration("scrape_interval", "timeInterval", "The scrape and evaluation interval of the Prometheus server"),
+				dsConfigDuration("query_timeout", "queryTimeout", "The timeout of the queries"),
+				dsConfigEnum("default_editor", "defaultEditor", "The default query editor", []string{"builder", "code"}),
+				dsConfigBool("disable_metrics_lookup", "disableMetricsLookup", "Disable the metrics chooser and the metric and label lookups in the query editor."),
+				dsConfigBool("incremental_querying", "incrementalQuerying", "Only query the new data when the dashboard is refreshed."),
+				dsConfigString("custom_query_parameters", "customQueryParameters", "Custom query parameters added to all queries. Ex: `max_source_resolution=5m&timeout=10`.", nil),
+				dsConfigObjectList("exemplar_trace_id_destinations", "exemplarTraceIdDestinations", "Links from the exemplars to traces.",
+					dsConfigString("name", "name", "The name of the exemplar label holding the trace ID.", validation.StringIsNotEmpty),
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the tracing data source. Either this or `url` must be set.", nil),
+					dsConfigString("url", "url", "The URL of the trace, for traces outside of Grafana. Use `${__value.raw}` to interpolate the trace ID.", nil),
+					dsConfigString("url_display_label", "urlDisplayLabel", "The label of the link.", nil),
+				),
+			},
+		},
+		{
+			attr:        "loki",
+			types:       []string{"loki"},
+			description: "Typed settings of Loki data sources.",
+			fields: []datasourceConfigField{
+				dsConfigString("max_lines", "maxLines", "The maximum number of log lines returned by queries. Ex: `1000`.", validation.StringMatch(numericStringRegexp, "must be a number")),
+				dsConfigObjectList("derived_fields", "derivedFields", "Fields extracted from the log lines, which can be used to link to other data sources or URLs.",
+					dsConfigString("name", "name", "The name of the field.", validation.StringIsNotEmpty),
+					dsConfigEnum("matcher_type", "matcherType", "How the value is extracted. Defaults to `regex`", []string{"regex", "label"}),
+					dsConfigString("matcher_regex", "matcherRegex", "The regex extracting the value from the log line, or the name of the label when `matcher_type` is `label`.", validation.StringIsNotEmpty),
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the data source to link to (ex: a tracing data source).", nil),
+					dsConfigString("url", "url", "The URL, or the query when `datasource_uid` is set. Use `${__value.raw}` to interpolate the value.", nil),
+					dsConfigString("url_display_label", "urlDisplayLabel", "The label of the link.", nil),
+				),
+			},
+		},
+		{
+			attr:        "tempo",
+			types:       []string{"tempo"},
+			description: "Typed settings of Tempo data sources.",
+			fields: []datasourceConfigField{
+				dsConfigObject("traces_to_logs", "tracesToLogsV2", "Links from the spans to logs.",
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the logs data source.", nil),
+					datasourceTraceTagsField,
+					dsConfigDuration("span_start_time_shift", "spanStartTimeShift", "Shifts the start of the logs time range"),
+					dsConfigDuration("span_end_time_shift", "spanEndTimeShift", "Shifts the end of the logs time range"),
+					dsConfigBool("filter_by_trace_id", "filterByTraceID", "Filter the logs by the trace ID."),
+					dsConfigBool("filter_by_span_id", "filterBySpanID", "Filter the logs by the span ID."),
+					dsConfigBool("custom_query", "customQuery", "Use `query` instead of the generated query."),
+					dsConfigString("query", "query", "The custom logs query.", nil),
+				),
+				dsConfigObject("traces_to_metrics", "tracesToMetrics", "Links from the spans to metrics.",
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the metrics data source.", nil),
+					datasourceTraceTagsField,
+					dsConfigDuration("span_start_time_shift", "spanStartTimeShift", "Shifts the start of the metrics time range"),
+					dsConfigDuration("span_end_time_shift", "spanEndTimeShift", "Shifts the end of the metrics time range"),
+					dsConfigObjectList("queries", "queries", "The metrics queries linked from the spans.",
+						dsConfigString("name", "name", "The name of the link.", nil),
+						dsConfigString("query", "query", "The metrics query. Use `$__tags` to interpolate the tags.", nil),
+					),
+				),
+				dsConfigObject("service_map", "serviceMap", "The service graph settings.",
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the Prometheus data source holding the service graph metrics.", nil),
+				),
+				dsConfigObject("node_graph", "nodeGraph", "The node graph settings.",
+					dsConfigBool("enabled", "enabled", "Show the node graph of traces."),
+				),
+			},
+		},
+		{
+			attr:        "elasticsearch",
+			types:       []string{"elasticsearch"},
+			description: "Typed settings of Elasticsearch data sources.",
+			fields: []datasourceConfigField{
+				dsConfigString("index", "index", "The index name or pattern. Ex: `[logs-]YYYY.MM.DD`.", nil),
+				dsConfigEnum("interval", "interval", "The pattern of the index names, if any", []string{"Hourly", "Daily", "Weekly", "Monthly", "Yearly"}),
+				dsConfigString("time_field", "timeField", "The name of the time field. Ex: `@timestamp`.", nil),
+				dsConfigDuration("time_interval", "timeInterval", "The lower limit of the auto group by time interval"),
+				dsConfigInt("max_concurrent_shard_requests", "maxConcurrentShardRequests", "The maximum number of concurrent shard requests of each query."),
+				dsConfigString("log_message_field", "logMessageField", "The field holding the log message.", nil),
+				dsConfigString("log_level_field", "logLevelField", "The field holding the log level.", nil),
+				dsConfigBool("include_frozen", "includeFrozen", "Include the frozen indices in searches."),
+				dsConfigObjectList("data_links", "dataLinks", "Links from the fields of the documents to URLs or other data sources.",
+					dsConfigString("field", "field", "The name of the field.", validation.StringIsNotEmpty),
+					dsConfigString("datasource_uid", "datasourceUid", "The UID of the data source to link to (ex: a tracing data source).", nil),
+					dsConfigString("url", "url", "The URL, or the query when `datasource_uid` is set. Use `${__value.raw}` to interpolate the value.", nil),
+					dsConfigString("url_display_label", "urlDisplayLabel", "The label of the link.", nil),
+				),
+			},
+		},
+		{
+			attr:        "cloudwatch",
+			types:       []string{"cloudwatch"},
+			description: "Typed settings of CloudWatch data sources.",
+			fields: []datasourceConfigField{
+				dsConfigEnum("auth_type", "authType", "The authentication provider", []string{"default", "keys", "credentials", "ec2_iam_role", "grafana_assume_role"}),
+				dsConfigString("default_region", "defaultRegion", "The default region. Ex: `us-east-1`.", nil),
+				dsConfigString("assume_role_arn", "assumeRoleArn", "The ARN of the role to assume.", nil),
+				dsConfigString("external_id", "externalId", "The external ID of the role to assume.", nil),
+				dsConfigString("profile", "profile", "The credentials profile, when `auth_type` is `credentials`.", nil),
+				dsConfigString("endpoint", "endpoint", "A custom endpoint for the CloudWatch API.", nil),
+				dsConfigString("custom_metrics_namespaces", "customMetricsNamespaces", "Comma-separated list of custom metrics namespaces.", nil),
+				dsConfigDuration("logs_timeout", "logsTimeout", "The timeout of the CloudWatch Logs queries"),
+				dsConfigSecret("access_key", "accessKey", "The access key ID, when `auth_type` is `keys`"),
+				dsConfigSecret("secret_key", "secretKey", "The secret access key, when `auth_type` is `keys`"),
+			},
+		},
+	}
+)
+
+// datasourceTypedConfigAttributes returns the typed config blocks, to add to the schema of resources managing the jsonData.
+func datasourceTypedConfigAttributes() map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(datasourceTypedConfigs))
+	for _, c := range datasourceTypedConfigs {
+		result[c.attr] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("%s Only valid for data sources of type `%s`. The settings are merged into `json_data_encoded`, and can't also be set there. When a data source is imported, its settings are read into this block.", c.description, strings.Join(c.types, "`, `")),
+			Elem: &schema.Resource{
+				Schema: datasourceConfigFieldsSchema(c.fields),
+			},
+		}
+	}
+	return result
+}
+
+// datasourceConfigGetter is implemented by *schema.ResourceData and *schema.ResourceDiff,
+// so the config is checked at plan time by the same code which builds it at apply time.
+type datasourceConfigGetter interface {
+	Get(key string) interface{}
+}
+
+// validateDatasourceTypedConfigs checks that the typed config blocks match the data source type.
+func validateDatasourceTypedConfigs(d datasourceConfigGetter, dsType string) error {
+	for _, c := range datasourceTypedConfigs {
+		if len(d.Get(c.attr).([]interface{})) == 0 {
+			continue
+		}
+		if !slices.Contains(c.types, dsType) {
+			return fmt.Errorf("the `%s` block can't be used with data sources of type %q", c.attr, dsType)
+		}
+	}
+	return nil
+}
+
+// diffDatasourceTypedConfigs checks at plan time that the settings of the typed config blocks aren't also set in
+// `json_data_encoded` or `secure_json_data_encoded`. Unknown values are checked at apply time.
+func diffDatasourceTypedConfigs(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("json_data_encoded") || !d.NewValueKnown("secure_json_data_encoded") {
+		return nil
+	}
+	jd, err := makeJSONData(d)
+	if err != nil {
+		return err
+	}
+	sd, err := makeSecureJSONData(d)
+	if err != nil {
+		return err
+	}
+	return packDatasourceTypedConfigs(d, jd, sd)
+}
+
+// packDatasourceTypedConfigs merges the typed config blocks into the jsonData and secureJsonData.
+func packDatasourceTypedConfigs(d datasourceConfigGetter, jd map[string]interface{}, sd map[string]string) error {
+	for _, c := range datasourceTypedConfigs {
+		block := d.Get(c.attr).([]interface{})
+		if len(block) == 0 || block[0] == nil {
+			continue
+		}
+		if err := packDatasourceConfigFields(c.fields, block[0].(map[string]interface{}), jd, sd, ""); err != nil {
+			return fmt.Errorf("invalid `%s` block: %w", c.attr, err)
+		}
+	}
+	return nil
+}
+
+func packDatasourceConfigFields(fields []datasourceConfigField, block map[string]interface{}, jd map[string]interface{}, sd map[string]string, path string) error {
+	for _, f := range fields {
+		if f.secure {
+			if v := block[f.attr].(string); v != "" {
+				if _, ok := sd[f.key]; ok {
+					return fmt.Errorf("%s is also set in `secure_json_data_encoded`", path+f.key)
+				}
+				sd[f.key] = v
+			}
+			continue
+		}
+
+		var value interface{}
+		switch {
+		case f.isObject():
+			list := block[f.attr].([]interface{})
+			if len(list) == 0 || list[0] == nil {
+				continue
+			}
+			object := map[string]interface{}{}
+			if existing, ok := jd[f.key]; ok {
+				// Other keys of the object can be set in `json_data_encoded`
+				if object, ok = existing.(map[string]interface{}); !ok {
+					return fmt.Errorf("%s is also set in `json_data_encoded`", path+f.key)
+				}
+			}
+			if err := packDatasourceConfigFields(f.fields, list[0].(map[string]interface{}), object, sd, path+f.key+"."); err != nil {
+				return err
+			}
+			if len(object) > 0 {
+				jd[f.key] = object
+			}
+			continue
+		case f.isObjectList():
+			list := block[f.attr].([]interface{})
+			if len(list) == 0 {
+				continue
+			}
+			items := make([]interface{}, 0, len(list))
+			for _, item := range list {
+				object := map[string]interface{}{}
+				if item != nil {
+					if err := packDatasourceConfigFields(f.fields, item.(map[string]interface{}), object, sd, path+f.key+"[]."); err != nil {
+						return err
+					}
+				}
+				items = append(items, object)
+			}
+			value = items
+		default:
+			value = block[f.attr]
+			if value == "" || value == false || value == 0 {
+				continue
+			}
+		}
+
+		if _, ok := jd[f.key]; ok {
+			return fmt.Errorf("%s is also set in `json_data_encoded`", path+f.key)
+		}
+		jd[f.key] = value
+	}
+	return nil
+}
+
+// datasourceTypedConfigsToState moves the settings of the typed config blocks used in the state from the jsonData to the blocks.
+// The remaining jsonData is stored in `json_data_encoded`.
+func datasourceTypedConfigsToState(d *schema.ResourceData, jd map[string]interface{}) {
+	for _, c := range datasourceTypedConfigs {
+		current := d.Get(c.attr).([]interface{})
+		if len(current) == 0 {
+			continue
+		}
+		currentBlock, _ := current[0].(map[string]interface{})
+		d.Set(c.attr, []interface{}{unpackDatasourceConfigFields(c.fields, jd, currentBlock)})
+	}
+}
+
+// importDatasourceTypedConfig adds the typed config block of the data source type to the state of an imported data source.
+// Only the blocks in the state are filled when reading, so the settings would otherwise stay in `json_data_encoded`.
+func importDatasourceTypedConfig(d *schema.ResourceData, dataSource *models.DataSource) {
+	jd, ok := dataSource.JSONData.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, c := range datasourceTypedConfigs {
+		if slices.Contains(c.types, dataSource.Type) && len(d.Get(c.attr).([]interface{})) == 0 {
+			d.Set(c.attr, []interface{}{unpackDatasourceConfigFields(c.fields, jd, nil)})
+		}
+	}
+}
+
+func unpackDatasourceConfigFields(fields []datasourceConfigField, jd map[string]interface{}, current map[string]interface{}) map[string]interface{} {
+	block := map[string]interface{}{}
+	for _, f := range fields {
+		switch {
+		case f.secure:
+			// Grafana doesn't return secrets, keep the value from the state
+			block[f.attr] = current[f.attr]
+		case f.isObject():
+			object, ok := jd[f.key].(map[string]interface{})
+			if !ok {
+				block[f.attr] = []interface{}{}
+				continue
+			}
+			var currentObject map[string]interface{}
+			if list, ok := current[f.attr].([]interface{}); ok && len(list) > 0 {
+				currentObject, _ = list[0].(map[string]interface{})
+			}
+			block[f.attr] = []interface{}{unpackDatasourceConfigFields(f.fields, object, currentObject)}
+			if len(object) == 0 {
+				delete(jd, f.key)
+			}
+		case f.isObjectList():
+			list, ok := jd[f.key].([]interface{})
+			if !ok {
+				block[f.attr] = []interface{}{}
+				continue
+			}
+			items := make([]interface{}, 0, len(list))
+			for _, item := range list {
+				object, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				items = append(items, unpackDatasourceConfigFields(f.fields, object, nil))
+			}
+			block[f.attr] = items
+			delete(jd, f.key)
+		default:
+			value, ok := jd[f.key]
+			switch f.schema.Type {
+			case schema.TypeInt:
+				if n, isNumber := value.(float64); isNumber {
+					block[f.attr] = int(n)
+					delete(jd, f.key)
+				}
+			case schema.TypeBool:
+				if _, isBool := value.(bool); isBool {
+					block[f.attr] = value
+					delete(jd, f.key)
+				}
+			default:
+				if _, isString := value.(string); isString {
+					block[f.attr] = value
+					delete(jd, f.key)
+				}
+			}
+			// Values with an unexpected type stay in `json_data_encoded`
+			if _, ok = block[f.attr]; !ok {
+				block[f.attr] = f.schema.ZeroValue()
+			}
+		}
+	}
+	return block
+}
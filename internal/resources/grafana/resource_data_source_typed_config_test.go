@@ -0,0 +1,226 @@
+package grafana
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_packDatasourceTypedConfigs(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     map[string]interface{}
+		jsonData   map[string]interface{}
+		secureData map[string]string
+		wantJSON   map[string]interface{}
+		wantSecure map[string]string
+		wantErr    string
+	}{
+		{
+			name: "zero values are not sent",
+			config: map[string]interface{}{
+				"prometheus": []interface{}{map[string]interface{}{"http_method": "POST", "disable_metrics_lookup": true}},
+			},
+			wantJSON: map[string]interface{}{"httpMethod": "POST", "disableMetricsLookup": true},
+		},
+		{
+			name: "other keys of json_data_encoded are kept",
+			config: map[string]interface{}{
+				"prometheus": []interface{}{map[string]interface{}{"http_method": "GET"}},
+			},
+			jsonData: map[string]interface{}{"manageAlerts": false},
+			wantJSON: map[string]interface{}{"httpMethod": "GET", "manageAlerts": false},
+		},
+		{
+			name: "a key can't also be set in json_data_encoded",
+			config: map[string]interface{}{
+				"prometheus": []interface{}{map[string]interface{}{"http_method": "GET"}},
+			},
+			jsonData: map[string]interface{}{"httpMethod": "POST"},
+			wantErr:  "invalid `prometheus` block: httpMethod is also set in `json_data_encoded`",
+		},
+		{
+			name: "objects are merged with json_data_encoded",
+			config: map[string]interface{}{
+				"tempo": []interface{}{map[string]interface{}{
+					"traces_to_logs": []interface{}{map[string]interface{}{"datasource_uid": "loki", "filter_by_trace_id": true}},
+				}},
+			},
+			jsonData: map[string]interface{}{"tracesToLogsV2": map[string]interface{}{"mappedTags": "custom"}},
+			wantJSON: map[string]interface{}{"tracesToLogsV2": map[string]interface{}{"datasourceUid": "loki", "filterByTraceID": true, "mappedTags": "custom"}},
+		},
+		{
+			name: "objects can't be set as another type in json_data_encoded",
+			config: map[string]interface{}{
+				"tempo": []interface{}{map[string]interface{}{
+					"traces_to_logs": []interface{}{map[string]interface{}{"datasource_uid": "loki"}},
+				}},
+			},
+			jsonData: map[string]interface{}{"tracesToLogsV2": "loki"},
+			wantErr:  "invalid `tempo` block: tracesToLogsV2 is also set in `json_data_encoded`",
+		},
+		{
+			name: "object lists",
+			config: map[string]interface{}{
+				"loki": []interface{}{map[string]interface{}{
+					"derived_fields": []interface{}{map[string]interface{}{"name": "traceID", "matcher_regex": "trace=(\\w+)", "datasource_uid": "tempo"}},
+				}},
+			},
+			wantJSON: map[string]interface{}{"derivedFields": []interface{}{map[string]interface{}{"name": "traceID", "matcherRegex": "trace=(\\w+)", "datasourceUid": "tempo"}}},
+		},
+		{
+			name: "secrets are sent in the secure json data",
+			config: map[string]interface{}{
+				"cloudwatch": []interface{}{map[string]interface{}{"auth_type": "keys", "access_key": "id", "secret_key": "secret"}},
+			},
+			wantJSON:   map[string]interface{}{"authType": "keys"},
+			wantSecure: map[string]string{"accessKey": "id", "secretKey": "secret"},
+		},
+		{
+			name: "a secret can't also be set in secure_json_data_encoded",
+			config: map[string]interface{}{
+				"cloudwatch": []interface{}{map[string]interface{}{"secret_key": "secret"}},
+			},
+			secureData: map[string]string{"secretKey": "other"},
+			wantErr:    "invalid `cloudwatch` block: secretKey is also set in `secure_json_data_encoded`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, datasourceTypedConfigAttributes(), tt.config)
+			jd, sd := tt.jsonData, tt.secureData
+			if jd == nil {
+				jd = map[string]interface{}{}
+			}
+			if sd == nil {
+				sd = map[string]string{}
+			}
+
+			err := packDatasourceTypedConfigs(d, jd, sd)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantJSON, jd)
+			if tt.wantSecure == nil {
+				tt.wantSecure = map[string]string{}
+			}
+			assert.Equal(t, tt.wantSecure, sd)
+		})
+	}
+}
+
+func Test_datasourceTypedConfigsToState(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       map[string]interface{}
+		jsonData     map[string]interface{}
+		attr         string
+		wantBlock    map[string]interface{} // by path in the block
+		wantJSONData map[string]interface{}
+	}{
+		{
+			name:     "settings are moved to the block",
+			config:   map[string]interface{}{"prometheus": []interface{}{map[string]interface{}{"http_method": "GET"}}},
+			jsonData: map[string]interface{}{"httpMethod": "POST", "timeInterval": "30s", "manageAlerts": true},
+			attr:     "prometheus",
+			wantBlock: map[string]interface{}{
+				"http_method":     "POST",
+				"scrape_interval": "30s",
+			},
+			wantJSONData: map[string]interface{}{"manageAlerts": true},
+		},
+		{
+			name:         "values with an unexpected type stay in the json data",
+			config:       map[string]interface{}{"prometheus": []interface{}{map[string]interface{}{"http_method": "GET"}}},
+			jsonData:     map[string]interface{}{"httpMethod": 1, "disableMetricsLookup": "yes"},
+			attr:         "prometheus",
+			wantBlock:    map[string]interface{}{"http_method": "", "disable_metrics_lookup": false},
+			wantJSONData: map[string]interface{}{"httpMethod": 1, "disableMetricsLookup": "yes"},
+		},
+		{
+			name:         "numbers are converted to ints",
+			config:       map[string]interface{}{"elasticsearch": []interface{}{map[string]interface{}{"index": "logs"}}},
+			jsonData:     map[string]interface{}{"index": "logs", "maxConcurrentShardRequests": float64(5)},
+			attr:         "elasticsearch",
+			wantBlock:    map[string]interface{}{"index": "logs", "max_concurrent_shard_requests": 5},
+			wantJSONData: map[string]interface{}{},
+		},
+		{
+			name: "secrets are kept from the state",
+			config: map[string]interface{}{"cloudwatch": []interface{}{map[string]interface{}{
+				"auth_type": "keys", "secret_key": "secret",
+			}}},
+			jsonData:     map[string]interface{}{"authType": "keys"},
+			attr:         "cloudwatch",
+			wantBlock:    map[string]interface{}{"auth_type": "keys", "secret_key": "secret"},
+			wantJSONData: map[string]interface{}{},
+		},
+		{
+			name: "nested objects and lists",
+			config: map[string]interface{}{"tempo": []interface{}{map[string]interface{}{
+				"traces_to_logs": []interface{}{map[string]interface{}{"datasource_uid": "loki"}},
+			}}},
+			jsonData: map[string]interface{}{
+				"tracesToLogsV2": map[string]interface{}{"datasourceUid": "loki", "tags": []interface{}{map[string]interface{}{"key": "service.name"}}},
+			},
+			attr: "tempo",
+			wantBlock: map[string]interface{}{
+				"traces_to_logs.0.datasource_uid": "loki",
+				"traces_to_logs.0.tags.#":         1,
+				"traces_to_logs.0.tags.0.key":     "service.name",
+				"traces_to_logs.0.tags.0.value":   "",
+			},
+			wantJSONData: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, datasourceTypedConfigAttributes(), tt.config)
+			datasourceTypedConfigsToState(d, tt.jsonData)
+
+			for k, v := range tt.wantBlock {
+				assert.Equal(t, v, d.Get(tt.attr+".0."+k), k)
+			}
+			assert.Equal(t, tt.wantJSONData, tt.jsonData)
+		})
+	}
+}
+
+func Test_validateDatasourceTypedConfigs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, datasourceTypedConfigAttributes(), map[string]interface{}{
+		"prometheus": []interface{}{map[string]interface{}{"http_method": "GET"}},
+	})
+	require.NoError(t, validateDatasourceTypedConfigs(d, "prometheus"))
+	require.EqualError(t, validateDatasourceTypedConfigs(d, "loki"), "the `prometheus` block can't be used with data sources of type \"loki\"")
+}
+
+func Test_importDatasourceTypedConfig(t *testing.T) {
+	jsonData := func() map[string]interface{} {
+		return map[string]interface{}{
+			"maxLines":      "1000",
+			"derivedFields": []interface{}{map[string]interface{}{"name": "traceID", "matcherRegex": "trace_id=(\\w+)"}},
+			"manageAlerts":  true,
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, datasourceTypedConfigAttributes(), map[string]interface{}{})
+	importDatasourceTypedConfig(d, &models.DataSource{Type: "loki", JSONData: jsonData()})
+	importDatasourceTypedConfig(d, &models.DataSource{Type: "influxdb", JSONData: jsonData()})
+
+	// The block of the type is filled when reading, the other settings stay in the json data
+	gotJSONData := jsonData()
+	datasourceTypedConfigsToState(d, gotJSONData)
+	assert.Equal(t, "1000", d.Get("loki.0.max_lines"))
+	assert.Equal(t, "traceID", d.Get("loki.0.derived_fields.0.name"))
+	assert.Equal(t, map[string]interface{}{"manageAlerts": true}, gotJSONData)
+	for _, c := range datasourceTypedConfigs {
+		if c.attr != "loki" {
+			assert.Empty(t, d.Get(c.attr), c.attr)
+		}
+	}
+}
@@ -3,8 +3,11 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/folders"
@@ -59,15 +62,15 @@ func resourceFolder() *common.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Prevent deletion of the folder if it is not empty (contains dashboards or alert rules). This feature requires Grafana 10.2 or later.",
+				Description: "Prevent deletion of the folder if it is not empty (contains dashboards, alert rules, library panels or subfolders, at any depth). This feature requires Grafana 10.2 or later.",
 			},
 			"parent_folder_uid": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Description: "The uid of the parent folder. " +
 					"If set, the folder will be nested. " +
 					"If not set, the folder will be created in the root folder. " +
+					"Changing it moves the folder, with its content, to the new parent. " +
 					"Note: This requires the nestedFolders feature flag to be enabled on your Grafana instance.",
 			},
 		},
@@ -120,6 +123,15 @@ func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("failed to get folder %s: %s", idStr, err)
 	}
 
+	if d.HasChange("parent_folder_uid") {
+		body := models.MoveFolderCommand{
+			ParentUID: d.Get("parent_folder_uid").(string),
+		}
+		if _, err := client.Folders.MoveFolder(folder.UID, &body); err != nil {
+			return diag.Errorf("failed to move folder %s: %s", folder.UID, err)
+		}
+	}
+
 	body := models.UpdateFolderCommand{
 		Overwrite: true,
 		Title:     d.Get("title").(string),
@@ -155,17 +167,8 @@ func DeleteFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
 	deleteParams := folders.NewDeleteFolderParams().WithFolderUID(uid)
 	if d.Get("prevent_destroy_if_not_empty").(bool) {
-		searchParams := search.NewSearchParams().WithFolderUIDs([]string{uid})
-		searchResp, err := client.Search.Search(searchParams)
-		if err != nil {
-			return diag.Errorf("failed to search for dashboards in folder: %s", err)
-		}
-		if len(searchResp.GetPayload()) > 0 {
-			var dashboardAndFolderNames []string
-			for _, dashboard := range searchResp.GetPayload() {
-				dashboardAndFolderNames = append(dashboardAndFolderNames, dashboard.Title)
-			}
-			return diag.Errorf("folder %s is not empty and prevent_destroy_if_not_empty is set. It contains the following dashboards and/or folders: %v", uid, dashboardAndFolderNames)
+		if err := checkFolderIsEmpty(client, uid); err != nil {
+			return diag.FromErr(err)
 		}
 	} else {
 		// If we're not preventing destroys, then we can force delete folders that have alert rules
@@ -178,6 +181,47 @@ func DeleteFolder(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return diag
 }
 
+// checkFolderIsEmpty returns an error if the folder contains anything, at any depth.
+// The descendant counts cover subfolders, alert rules and library panels. The direct children are listed in the error.
+func checkFolderIsEmpty(client *goapi.GrafanaHTTPAPI, uid string) error {
+	var contents []string
+	countsResp, err := client.Folders.GetFolderDescendantCounts(uid)
+	if err != nil && !common.IsNotFoundError(err) {
+		return fmt.Errorf("failed to count the content of folder: %w", err)
+	} else if err == nil {
+		counts := countsResp.GetPayload()
+		kinds := make([]string, 0, len(counts))
+		for kind := range counts {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			if counts[kind] > 0 {
+				contents = append(contents, fmt.Sprintf("%d %s(s)", counts[kind], kind))
+			}
+		}
+	}
+
+	searchParams := search.NewSearchParams().WithFolderUIDs([]string{uid})
+	searchResp, err := client.Search.Search(searchParams)
+	if err != nil {
+		return fmt.Errorf("failed to search for dashboards in folder: %w", err)
+	}
+	var dashboardAndFolderNames []string
+	for _, dashboard := range searchResp.GetPayload() {
+		dashboardAndFolderNames = append(dashboardAndFolderNames, dashboard.Title)
+	}
+
+	if len(contents) == 0 && len(dashboardAndFolderNames) == 0 {
+		return nil
+	}
+	if len(contents) == 0 {
+		// Descendant counts aren't available in this Grafana version
+		return fmt.Errorf("folder %s is not empty and prevent_destroy_if_not_empty is set. It contains the following dashboards and/or folders: %v", uid, dashboardAndFolderNames)
+	}
+	return fmt.Errorf("folder %s is not empty and prevent_destroy_if_not_empty is set. It contains %s, including subfolders. Direct dashboards and/or folders: %v", uid, strings.Join(contents, ", "), dashboardAndFolderNames)
+}
+
 func ValidateFolderConfigJSON(configI interface{}, k string) ([]string, []error) {
 	configJSON := configI.(string)
 	configMap := map[string]interface{}{}
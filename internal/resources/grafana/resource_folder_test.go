@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/grafana-openapi-client-go/models"
 
@@ -171,6 +173,71 @@ resource grafana_folder child2 {
 	})
 }
 
+func TestAccFolder_move(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	var parentFolder models.Folder
+	var childFolder models.Folder
+	var movedFolder models.Folder
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	config := func(parent string) string {
+		return fmt.Sprintf(`
+resource grafana_folder parent {
+	title = "Move Test: Parent %[1]s"
+	uid   = "%[1]s-parent"
+}
+
+resource grafana_folder child {
+	title = "Move Test: Child %[1]s"
+	uid   = "%[1]s-child"
+}
+
+resource grafana_folder moved {
+	title             = "Move Test: Moved %[1]s"
+	uid               = "%[1]s-moved"
+	parent_folder_uid = %[2]s
+}
+`, name, parent)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			folderCheckExists.destroyed(&parentFolder, nil),
+			folderCheckExists.destroyed(&childFolder, nil),
+			folderCheckExists.destroyed(&movedFolder, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config("grafana_folder.parent.uid"),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.parent", &parentFolder),
+					folderCheckExists.exists("grafana_folder.child", &childFolder),
+					folderCheckExists.exists("grafana_folder.moved", &movedFolder),
+					resource.TestCheckResourceAttr("grafana_folder.moved", "parent_folder_uid", name+"-parent"),
+				),
+			},
+			{
+				// Move to another parent, the folder isn't recreated
+				Config: config("grafana_folder.child.uid"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_folder.moved", "parent_folder_uid", name+"-child"),
+					testAccFolderIDDidntChange("grafana_folder.moved", &movedFolder),
+				),
+			},
+			{
+				// Move to the root
+				Config: config("null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_folder.moved", "parent_folder_uid", ""),
+					testAccFolderIDDidntChange("grafana_folder.moved", &movedFolder),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFolder_PreventDeletion(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=10.2.0") // Searching by folder UID was added in 10.2.0
 
@@ -267,6 +334,69 @@ func TestAccFolder_PreventDeletionNested(t *testing.T) {
 	})
 }
 
+func TestAccFolder_PreventDeletionAlertRule(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=10.3.0")
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var folder models.Folder
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// test with an alert rule in a subfolder of the folder:
+			{
+				Config: testAccFolderExample_PreventDeletion(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					folderCheckExists.exists("grafana_folder.test_folder", &folder),
+					func(s *terraform.State) error {
+						client := grafanaTestClient()
+						subfolderUID := name + "-sub"
+						if _, err := client.Folders.CreateFolder(&models.CreateFolderCommand{
+							Title:     "Inner folder",
+							ParentUID: name,
+							UID:       subfolderUID,
+						}); err != nil {
+							return err
+						}
+						ruleFor := strfmt.Duration(0)
+						_, err := client.Provisioning.PostAlertRule(provisioning.NewPostAlertRuleParams().WithBody(&models.ProvisionedAlertRule{
+							Title:        common.Ref("Inner rule"),
+							FolderUID:    &subfolderUID,
+							RuleGroup:    common.Ref("group"),
+							OrgID:        common.Ref(folder.OrgID),
+							Condition:    common.Ref("A"),
+							For:          &ruleFor,
+							NoDataState:  common.Ref("NoData"),
+							ExecErrState: common.Ref("Alerting"),
+							Data: []*models.AlertQuery{{
+								RefID:             "A",
+								DatasourceUID:     "__expr__",
+								RelativeTimeRange: &models.RelativeTimeRange{From: 600},
+								Model:             map[string]interface{}{"type": "math", "expression": "2 + 2 > 1"},
+							}},
+						}))
+						return err
+					},
+				),
+			},
+			{
+				Config:  testAccFolderExample_PreventDeletion(name, true),
+				Destroy: true, // Try to delete the protected folder
+				ExpectError: regexp.MustCompile(
+					fmt.Sprintf(`.+folder %s is not empty and prevent_destroy_if_not_empty is set. It contains 1 alertrule\(s\), 1 folder\(s\).+`, name),
+				), // Fail because it's protected
+			},
+			{
+				Config: testAccFolderExample_PreventDeletion(name, false), // Remove protected flag
+			},
+			{
+				Config:  testAccFolderExample_PreventDeletion(name, false),
+				Destroy: true, // No error if the folder is not protected
+			},
+		},
+	})
+}
+
 // This is a bug in Grafana, not the provider. It was fixed in 9.2.7+ and 9.3.0+, this test will check for regressions
 func TestAccFolder_createFromDifferentRoles(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=9.2.7")
@@ -361,6 +491,19 @@ func testAccFolderWasntRecreated(rn string, oldFolder *models.Folder) resource.T
 	}
 }
 
+func testAccFolderIDDidntChange(rn string, oldFolder *models.Folder) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		newFolder := &models.Folder{}
+		if err := folderCheckExists.exists(rn, newFolder)(s); err != nil {
+			return err
+		}
+		if newFolder.ID != oldFolder.ID {
+			return fmt.Errorf("folder was recreated: ID %d -> %d", oldFolder.ID, newFolder.ID)
+		}
+		return nil
+	}
+}
+
 func testAccFolderExample_PreventDeletion(name string, preventDeletion bool) string {
 	preventDeletionStr := ""
 	if preventDeletion {
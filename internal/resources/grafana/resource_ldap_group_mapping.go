@@ -0,0 +1,267 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// ldapSettingsMutex serializes the updates of the LDAP settings, which hold all the group mappings.
+	ldapSettingsMutex sync.Mutex
+
+	resourceLDAPGroupMappingID = common.NewResourceID(
+		common.StringIDField("serverHost"),
+		common.IntIDField("orgID"),
+		common.StringIDField("groupDN"),
+	)
+)
+
+func resourceLDAPGroupMapping() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Maps an LDAP group to a role in a Grafana organization. The mapping is added to the LDAP server configured in the SSO settings, the other mappings of the server are kept.
+
+**Note:** This resource requires Grafana 11.3+, with LDAP configured through the SSO settings. When it's used, ` + "`ignore_group_mappings`" + ` must be enabled for the server in the ` + "`ldap_settings`" + ` of ` + "`grafana_sso_settings`" + `.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-authentication/ldap/#group-mappings)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/sso-settings/)
+`,
+		CreateContext: createLDAPGroupMapping,
+		ReadContext:   readLDAPGroupMapping,
+		UpdateContext: updateLDAPGroupMapping,
+		DeleteContext: deleteLDAPGroupMapping,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"server_host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The host of the LDAP server, as set in the `ldap_settings` of `grafana_sso_settings`.",
+			},
+			"group_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The distinguished name (DN) of the LDAP group. Use `*` to match all users.",
+			},
+			"org_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The role of the members of the group in the organization. Can be `Admin`, `Editor`, `Viewer` or `None`.",
+				ValidateFunc: validation.StringInSlice([]string{"Admin", "Editor", "Viewer", "None"}, false),
+			},
+			"grafana_admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the members of the group are Grafana server admins.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_ldap_group_mapping",
+		resourceLDAPGroupMappingID,
+		schema,
+	)
+}
+
+// splitLDAPGroupMappingID splits the ID of a group mapping. Group DNs may contain colons, so the ID can't be split by the generic helpers.
+func splitLDAPGroupMappingID(id string) (serverHost string, orgID int64, groupDN string, err error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", 0, "", fmt.Errorf("id %q does not match expected format. Should be in the format: serverHost:orgID:groupDN", id)
+	}
+	if orgID, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return "", 0, "", fmt.Errorf("expected int for field %q, got %q", "orgID", parts[1])
+	}
+	return parts[0], orgID, parts[2], nil
+}
+
+func createLDAPGroupMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	serverHost, groupDN := d.Get("server_host").(string), d.Get("group_dn").(string)
+
+	ldapSettingsMutex.Lock()
+	defer ldapSettingsMutex.Unlock()
+
+	err := updateLDAPGroupMappings(client, serverHost, func(mappings []any) ([]any, error) {
+		if findLDAPGroupMapping(mappings, orgID, groupDN) >= 0 {
+			return nil, fmt.Errorf("group %s is already mapped in org %d, import the mapping instead", groupDN, orgID)
+		}
+		return append(mappings, makeLDAPGroupMapping(d, orgID)), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceLDAPGroupMappingID.Make(serverHost, orgID, groupDN))
+	return readLDAPGroupMapping(ctx, d, meta)
+}
+
+func readLDAPGroupMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	serverHost, orgID, groupDN, err := splitLDAPGroupMappingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+
+	_, server, err := getLDAPServer(client, serverHost)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if server == nil {
+		return common.WarnMissing("LDAP server", d)
+	}
+	mappings, _ := server["group_mappings"].([]any)
+	i := findLDAPGroupMapping(mappings, orgID, groupDN)
+	if i < 0 {
+		return common.WarnMissing("LDAP group mapping", d)
+	}
+	mapping := mappings[i].(map[string]any)
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("server_host", serverHost)
+	d.Set("group_dn", groupDN)
+	d.Set("org_role", mapping["org_role"])
+	grafanaAdmin, _ := mapping["grafana_admin"].(bool)
+	d.Set("grafana_admin", grafanaAdmin)
+
+	return nil
+}
+
+func updateLDAPGroupMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	serverHost, orgID, groupDN, err := splitLDAPGroupMappingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+
+	ldapSettingsMutex.Lock()
+	defer ldapSettingsMutex.Unlock()
+
+	err = updateLDAPGroupMappings(client, serverHost, func(mappings []any) ([]any, error) {
+		i := findLDAPGroupMapping(mappings, orgID, groupDN)
+		if i < 0 {
+			return nil, fmt.Errorf("group %s is not mapped in org %d", groupDN, orgID)
+		}
+		mappings[i] = makeLDAPGroupMapping(d, orgID)
+		return mappings, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readLDAPGroupMapping(ctx, d, meta)
+}
+
+func deleteLDAPGroupMapping(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	serverHost, orgID, groupDN, err := splitLDAPGroupMappingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+
+	ldapSettingsMutex.Lock()
+	defer ldapSettingsMutex.Unlock()
+
+	err = updateLDAPGroupMappings(client, serverHost, func(mappings []any) ([]any, error) {
+		i := findLDAPGroupMapping(mappings, orgID, groupDN)
+		if i < 0 {
+			return mappings, nil
+		}
+		return append(mappings[:i], mappings[i+1:]...), nil
+	})
+	return diag.FromErr(err)
+}
+
+func makeLDAPGroupMapping(d *schema.ResourceData, orgID int64) map[string]any {
+	return map[string]any{
+		"group_dn":      d.Get("group_dn").(string),
+		"org_id":        orgID,
+		"org_role":      d.Get("org_role").(string),
+		"grafana_admin": d.Get("grafana_admin").(bool),
+	}
+}
+
+// findLDAPGroupMapping returns the index of the mapping of the group in the org, or -1 if there is none.
+func findLDAPGroupMapping(mappings []any, orgID int64, groupDN string) int {
+	for i, item := range mappings {
+		mapping, ok := item.(map[string]any)
+		if !ok || mapping["group_dn"] != groupDN {
+			continue
+		}
+		// Mappings without org apply to the default org
+		mappingOrgID := int64(1)
+		if v, ok := mapping["org_id"].(float64); ok && v > 0 {
+			mappingOrgID = int64(v)
+		}
+		if mappingOrgID == orgID {
+			return i
+		}
+	}
+	return -1
+}
+
+// getLDAPServer returns the settings of the LDAP provider, as returned by the SSO settings API, and the server with the given host.
+// The server is nil if it's not configured.
+func getLDAPServer(client *goapi.GrafanaHTTPAPI, serverHost string) (map[string]any, map[string]any, error) {
+	resp, err := client.SsoSettings.GetProviderSettings("ldap")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the LDAP settings: %w", err)
+	}
+	settings, ok := resp.Payload.Settings.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid settings format: %v", resp.Payload.Settings)
+	}
+
+	config, _ := settings["config"].(map[string]any)
+	servers, _ := config["servers"].([]any)
+	for _, item := range servers {
+		if server, ok := item.(map[string]any); ok && server["host"] == serverHost {
+			return settings, server, nil
+		}
+	}
+	return settings, nil, nil
+}
+
+// updateLDAPGroupMappings updates the group mappings of an LDAP server. The caller must hold the ldapSettingsMutex.
+func updateLDAPGroupMappings(client *goapi.GrafanaHTTPAPI, serverHost string, update func(mappings []any) ([]any, error)) error {
+	settings, server, err := getLDAPServer(client, serverHost)
+	if err != nil {
+		return err
+	}
+	if server == nil {
+		return fmt.Errorf("LDAP server %s is not configured", serverHost)
+	}
+
+	mappings, _ := server["group_mappings"].([]any)
+	if mappings, err = update(mappings); err != nil {
+		return err
+	}
+	server["group_mappings"] = mappings
+
+	_, err = client.SsoSettings.UpdateProviderSettings("ldap", &models.UpdateProviderSettingsParamsBody{
+		Provider: "ldap",
+		Settings: settings,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update the group mappings of LDAP server %s: %w", serverHost, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLDAPGroupMapping_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.3")
+
+	api := grafanaTestClient()
+	defaultSettings, err := api.SsoSettings.GetProviderSettings("ldap")
+	if err != nil {
+		t.Fatalf("failed to fetch the default settings for provider ldap: %v", err)
+	}
+
+	config := testutils.TestAccExample(t, "resources/grafana_ldap_group_mapping/resource.tf")
+	updatedConfig := strings.ReplaceAll(config, `"(cn=%s)"`, `"(uid=%s)"`)
+
+	// The SSO settings are global, the test can't run in parallel with the SSO settings tests
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             checkSsoSettingsReset(api, "ldap", defaultSettings.Payload),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_ldap_group_mapping.admins", "id", "openldap:1:cn=admins,ou=groups,dc=grafana,dc=org"),
+					resource.TestCheckResourceAttr("grafana_ldap_group_mapping.admins", "org_role", "Admin"),
+					resource.TestCheckResourceAttr("grafana_ldap_group_mapping.admins", "grafana_admin", "true"),
+					resource.TestCheckResourceAttr("grafana_ldap_group_mapping.editors", "org_role", "Editor"),
+					resource.TestCheckResourceAttr("grafana_ldap_group_mapping.editors", "grafana_admin", "false"),
+					// The mappings aren't read by the SSO settings resource
+					resource.TestCheckResourceAttr("grafana_sso_settings.ldap", "ldap_settings.0.config.0.servers.0.group_mappings.#", "0"),
+					checkLDAPGroupMappingCount(api, 2),
+				),
+			},
+			{
+				ResourceName:      "grafana_ldap_group_mapping.admins",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Updating the SSO settings keeps the mappings
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_sso_settings.ldap", "ldap_settings.0.config.0.servers.0.search_filter", "(uid=%s)"),
+					checkLDAPGroupMappingCount(api, 2),
+				),
+			},
+			{
+				Config: testutils.WithoutResource(t, updatedConfig, "grafana_ldap_group_mapping.editors"),
+				Check:  checkLDAPGroupMappingCount(api, 1),
+			},
+		},
+	})
+}
+
+func checkLDAPGroupMappingCount(api *client.GrafanaHTTPAPI, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := api.SsoSettings.GetProviderSettings("ldap")
+		if err != nil {
+			return err
+		}
+		config, _ := resp.Payload.Settings.(map[string]any)["config"].(map[string]any)
+		servers, _ := config["servers"].([]any)
+		if len(servers) != 1 {
+			return fmt.Errorf("expected 1 LDAP server, got %d", len(servers))
+		}
+		mappings, _ := servers[0].(map[string]any)["group_mappings"].([]any)
+		if len(mappings) != expected {
+			return fmt.Errorf("expected %d group mappings, got %d", expected, len(mappings))
+		}
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,6 +11,7 @@ import (
 
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/library_elements"
+	"github.com/grafana/grafana-openapi-client-go/client/search"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 )
@@ -108,6 +110,18 @@ Manages Grafana library panels.
 				Description: "Numerical IDs of Grafana dashboards containing the library panel.",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			"dashboard_uids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of Grafana dashboards containing the library panel.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"prevent_destroy_if_connected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevent deletion of the library panel if it is connected to dashboards. The error lists the UIDs of the connected dashboards.",
+			},
 		},
 	}
 
@@ -180,19 +194,49 @@ func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("created", panel.Meta.Created.String())
 	d.Set("updated", panel.Meta.Updated.String())
 
-	connResp, err := client.LibraryElements.GetLibraryElementConnections(uid)
+	dashboardIDs, dashboardUIDs, err := getLibraryPanelConnections(client, uid)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("dashboard_ids", dashboardIDs)
+	d.Set("dashboard_uids", dashboardUIDs)
+
+	return nil
+}
+
+// getLibraryPanelConnections returns the IDs and UIDs of the dashboards connected to a library panel.
+// Older Grafana versions don't return the dashboard UIDs in connections, they are then searched by ID.
+func getLibraryPanelConnections(client *goapi.GrafanaHTTPAPI, uid string) ([]int64, []string, error) {
+	connResp, err := client.LibraryElements.GetLibraryElementConnections(uid)
+	if err != nil {
+		return nil, nil, err
+	}
 	connections := connResp.Payload.Result
 
-	dashboardIds := make([]int64, 0, len(connections))
+	dashboardIDs := make([]int64, 0, len(connections))
+	dashboardUIDs := make([]string, 0, len(connections))
+	var missingUIDs []int64
 	for _, connection := range connections {
-		dashboardIds = append(dashboardIds, connection.ConnectionID)
+		dashboardIDs = append(dashboardIDs, connection.ConnectionID)
+		if connection.ConnectionUID != "" {
+			dashboardUIDs = append(dashboardUIDs, connection.ConnectionUID)
+		} else {
+			missingUIDs = append(missingUIDs, connection.ConnectionID)
+		}
 	}
-	d.Set("dashboard_ids", dashboardIds)
 
-	return nil
+	if len(missingUIDs) > 0 {
+		searchResp, err := client.Search.Search(search.NewSearchParams().WithType(common.Ref("dash-db")).WithDashboardIds(missingUIDs))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, hit := range searchResp.Payload {
+			dashboardUIDs = append(dashboardUIDs, hit.UID)
+		}
+	}
+	sort.Strings(dashboardUIDs)
+
+	return dashboardIDs, dashboardUIDs, nil
 }
 
 func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -220,6 +264,15 @@ func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interf
 
 func deleteLibraryPanel(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	if d.Get("prevent_destroy_if_connected").(bool) {
+		_, dashboardUIDs, err := getLibraryPanelConnections(client, uid)
+		if err, shouldReturn := common.CheckReadError("library panel", d, err); shouldReturn {
+			return err
+		}
+		if len(dashboardUIDs) > 0 {
+			return diag.Errorf("library panel %s is connected to dashboards and prevent_destroy_if_connected is set. It is used by the following dashboards: %v", uid, dashboardUIDs)
+		}
+	}
 	_, err := client.LibraryElements.DeleteLibraryElementByUID(uid)
 	diag, _ := common.CheckReadError("library panel", d, err)
 	return diag
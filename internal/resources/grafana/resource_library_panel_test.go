@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccLibraryPanel_basic(t *testing.T) {
@@ -49,9 +51,10 @@ func TestAccLibraryPanel_basic(t *testing.T) {
 			},
 			{
 				// Importing matches the state of the previous step.
-				ResourceName:      "grafana_library_panel.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "grafana_library_panel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_destroy_if_connected"},
 			},
 		},
 	})
@@ -82,9 +85,10 @@ func TestAccLibraryPanel_folder(t *testing.T) {
 				),
 			},
 			{
-				ImportState:       true,
-				ResourceName:      "grafana_library_panel.test_folder",
-				ImportStateVerify: true,
+				ImportState:             true,
+				ResourceName:            "grafana_library_panel.test_folder",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_destroy_if_connected"},
 			},
 		},
 	})
@@ -107,12 +111,74 @@ func TestAccLibraryPanel_dashboard(t *testing.T) {
 					resource.TestMatchResourceAttr("grafana_library_panel.dashboard", "id", defaultOrgIDRegexp),
 					libraryPanelCheckExists.exists("grafana_library_panel.dashboard", &panel),
 					dashboardCheckExists.exists("grafana_dashboard.with_library_panel", &dashboard),
+					resource.TestCheckResourceAttr("data.grafana_library_panel.connected_to_dashboard", "dashboard_uids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.grafana_library_panel.connected_to_dashboard", "dashboard_uids.0", "grafana_dashboard.with_library_panel", "uid"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccLibraryPanel_preventDestroyIfConnected(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.0.0")
+
+	name := acctest.RandString(10)
+	var panel models.LibraryElementResponse
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             libraryPanelCheckExists.destroyed(&panel, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLibraryPanelPreventDestroy(name),
+				Check: resource.ComposeTestCheckFunc(
+					libraryPanelCheckExists.exists("grafana_library_panel.test", &panel),
+					// Connect the library panel to a dashboard from outside Terraform
+					func(s *terraform.State) error {
+						client := grafanaTestClient()
+						_, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
+							Dashboard: map[string]interface{}{
+								"uid":   name + "-dashboard",
+								"title": name + "-dashboard",
+								"panels": []interface{}{
+									map[string]interface{}{
+										"id":           1,
+										"gridPos":      map[string]interface{}{"x": 0, "y": 0, "w": 12, "h": 8},
+										"libraryPanel": map[string]interface{}{"uid": panel.Result.UID, "name": name},
+									},
+								},
+							},
+						})
+						return err
+					},
+				),
+			},
+			{
+				Config: testAccLibraryPanelPreventDestroy(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_library_panel.test", "dashboard_uids.#", "1"),
+					resource.TestCheckResourceAttr("grafana_library_panel.test", "dashboard_uids.0", name+"-dashboard"),
+				),
+			},
+			{
+				Config:      testAccLibraryPanelPreventDestroy(name),
+				Destroy:     true, // Try to delete the protected library panel
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`library panel .+ is connected to dashboards and prevent_destroy_if_connected is set. It is used by the following dashboards: \[%s-dashboard\]`, name)),
+			},
+			{
+				PreConfig: func() {
+					client := grafanaTestClient()
+					if _, err := client.Dashboards.DeleteDashboardByUID(name + "-dashboard"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:  testAccLibraryPanelPreventDestroy(name),
+				Destroy: true, // No error once the dashboard is deleted
+			},
+		},
+	})
+}
+
 func TestAccLibraryPanel_inOrg(t *testing.T) {
 	testutils.CheckOSSTestsEnabled(t, ">=8.0.0")
 
@@ -163,6 +229,18 @@ resource "grafana_library_panel" "test_folder" {
 }`, name)
 }
 
+func testAccLibraryPanelPreventDestroy(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_library_panel" "test" {
+	name                         = "%[1]s"
+	prevent_destroy_if_connected = true
+	model_json = jsonencode({
+		title = "%[1]s",
+		type  = "text",
+	})
+}`, name)
+}
+
 func testAccLibraryPanelInOrganization(orgName string) string {
 	return fmt.Sprintf(`
 resource "grafana_organization" "test" {
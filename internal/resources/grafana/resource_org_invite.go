@@ -0,0 +1,205 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceOrgInviteID = orgResourceIDString("email")
+
+func resourceOrgInvite() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Invites a user to an organization. The invited user sets their own password when accepting the invite, through the link sent by email or the ` + "`invite_url`" + `. If a user with this email already exists, they are added to the organization directly.
+
+Once the invite is accepted, the role of the user in the organization is managed by this resource, and destroying it doesn't remove the user from the organization. If the invite is revoked or expires before being accepted, it is sent again on the next apply.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/user-management/manage-org-users/#invite-a-user-to-join-an-organization)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/org/#add-invite)
+`,
+
+		CreateContext: createOrgInvite,
+		ReadContext:   readOrgInvite,
+		UpdateContext: updateOrgInvite,
+		DeleteContext: deleteOrgInvite,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The email of the invited user.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the invited user.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The role of the user in the organization. Can be `Viewer`, `Editor`, `Admin` or `None`.",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
+			},
+			"send_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the invite is sent by email. Requires SMTP to be configured in Grafana. If not, the `invite_url` must be shared with the user.",
+			},
+			"accepted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the invite was accepted, the user is then a member of the organization.",
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The numerical ID of the user, once the invite is accepted.",
+			},
+			"invite_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The link to accept the invite, while it's pending.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_org_invite",
+		resourceOrgInviteID,
+		schema,
+	)
+}
+
+func createOrgInvite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	email := d.Get("email").(string)
+
+	if err := addOrgInvite(client, d); err != nil {
+		return diag.Errorf("failed to invite %s to org %d: %s", email, orgID, err)
+	}
+
+	d.SetId(resourceOrgInviteID.Make(orgID, email))
+	return readOrgInvite(ctx, d, meta)
+}
+
+func readOrgInvite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, email := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	invite, err := findPendingOrgInvite(client, email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if invite != nil {
+		d.Set("org_id", strconv.FormatInt(orgID, 10))
+		d.Set("email", email)
+		d.Set("name", invite.Name)
+		d.Set("role", invite.Role)
+		d.Set("accepted", false)
+		d.Set("user_id", 0)
+		d.Set("invite_url", invite.URL)
+		return nil
+	}
+
+	// Accepted invites are removed from the pending ones, the user is then a member of the org
+	user, err := findOrgUser(client, func(user *models.OrgUserDTO) bool { return strings.EqualFold(user.Email, email) })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil {
+		return common.WarnMissing("org invite", d)
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("email", email)
+	d.Set("role", user.Role)
+	d.Set("accepted", true)
+	d.Set("user_id", user.UserID)
+	d.Set("invite_url", "")
+	return nil
+}
+
+func updateOrgInvite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, email := OAPIClientFromExistingOrgResource(meta, d.Id())
+
+	if d.Get("accepted").(bool) {
+		if d.HasChange("role") {
+			userID := int64(d.Get("user_id").(int))
+			if _, err := client.Org.UpdateOrgUserForCurrentOrg(userID, &models.UpdateOrgUserCommand{Role: d.Get("role").(string)}); err != nil {
+				return diag.Errorf("failed to update the role of user %d: %s", userID, err)
+			}
+		}
+		return readOrgInvite(ctx, d, meta)
+	}
+
+	// Pending invites can't be updated, they are revoked and sent again
+	if err := revokeOrgInvite(client, email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := addOrgInvite(client, d); err != nil {
+		return diag.Errorf("failed to invite %s: %s", email, err)
+	}
+
+	return readOrgInvite(ctx, d, meta)
+}
+
+func deleteOrgInvite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, email := OAPIClientFromExistingOrgResource(meta, d.Id())
+	return diag.FromErr(revokeOrgInvite(client, email))
+}
+
+func addOrgInvite(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData) error {
+	_, err := client.OrgInvites.AddOrgInvite(&models.AddInviteForm{
+		LoginOrEmail: d.Get("email").(string),
+		Name:         d.Get("name").(string),
+		Role:         d.Get("role").(string),
+		SendEmail:    d.Get("send_email").(bool),
+	})
+	return err
+}
+
+// revokeOrgInvite revokes the pending invite of an email, if there is one.
+func revokeOrgInvite(client *goapi.GrafanaHTTPAPI, email string) error {
+	invite, err := findPendingOrgInvite(client, email)
+	if err != nil || invite == nil {
+		return err
+	}
+	if _, err := client.OrgInvites.RevokeInvite(invite.Code); err != nil {
+		return fmt.Errorf("failed to revoke the invite of %s: %w", email, err)
+	}
+	return nil
+}
+
+// findPendingOrgInvite returns the latest pending invite of an email in the current org, or nil if there is none.
+func findPendingOrgInvite(client *goapi.GrafanaHTTPAPI, email string) (*models.TempUserDTO, error) {
+	resp, err := client.OrgInvites.GetPendingOrgInvites()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list org invites: %w", err)
+	}
+	var latest *models.TempUserDTO
+	for _, invite := range resp.Payload {
+		if strings.EqualFold(invite.Email, email) && (latest == nil || invite.ID > latest.ID) {
+			latest = invite
+		}
+	}
+	return latest, nil
+}
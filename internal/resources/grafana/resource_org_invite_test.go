@@ -0,0 +1,143 @@
+package grafana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOrgInvite_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	name := acctest.RandString(10)
+	email := name + "@example.com"
+	// SMTP isn't configured in the test environment
+	config := testutils.TestAccExampleWithReplace(t, "resources/grafana_org_invite/resource.tf", map[string]string{
+		"alice@example.com": email,
+		`"Alice"`:           `"` + name + `"`,
+		`"Editor"`:          `"Editor"` + "\n  send_email = false",
+	})
+
+	t.Cleanup(func() {
+		client := grafanaTestClient()
+		if user, err := client.Users.GetUserByLoginOrEmail(email); err == nil {
+			if _, err := client.AdminUsers.AdminDeleteUser(user.Payload.ID); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccOrgInviteCheckPending(email, false),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("grafana_org_invite.alice", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "email", email),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "role", "Editor"),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "accepted", "false"),
+					resource.TestCheckResourceAttrSet("grafana_org_invite.alice", "invite_url"),
+					testAccOrgInviteCheckPending(email, true),
+				),
+			},
+			{
+				ResourceName:            "grafana_org_invite.alice",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_email"},
+			},
+			// Pending invites are sent again when they are updated
+			{
+				Config: strings.Replace(config, `"Editor"`, `"Viewer"`, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "role", "Viewer"),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "accepted", "false"),
+					testAccOrgInviteCheckPending(email, true),
+				),
+			},
+			// The user accepts the invite, they are then a member of the org
+			{
+				PreConfig: func() { testAccOrgInviteComplete(t, email, name) },
+				Config:    strings.Replace(config, `"Editor"`, `"Viewer"`, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "accepted", "true"),
+					resource.TestCheckResourceAttrSet("grafana_org_invite.alice", "user_id"),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "invite_url", ""),
+					testAccOrgInviteCheckPending(email, false),
+				),
+			},
+			// The role of the member is updated
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "role", "Editor"),
+					resource.TestCheckResourceAttr("grafana_org_invite.alice", "accepted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrgInviteCheckPending(email string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := grafanaTestClient().WithOrgID(1).OrgInvites.GetPendingOrgInvites()
+		if err != nil {
+			return err
+		}
+		pending := false
+		for _, invite := range resp.Payload {
+			pending = pending || strings.EqualFold(invite.Email, email)
+		}
+		if pending != expected {
+			return fmt.Errorf("expected invite of %s to be pending: %t, got %t", email, expected, pending)
+		}
+		return nil
+	}
+}
+
+// testAccOrgInviteComplete accepts the pending invite of an email, like a user following the invite link.
+func testAccOrgInviteComplete(t *testing.T, email, name string) {
+	t.Helper()
+
+	resp, err := grafanaTestClient().WithOrgID(1).OrgInvites.GetPendingOrgInvites()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := ""
+	for _, invite := range resp.Payload {
+		if strings.EqualFold(invite.Email, email) {
+			code = invite.Code
+		}
+	}
+	if code == "" {
+		t.Fatalf("no pending invite for %s", email)
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"inviteCode":      code,
+		"email":           email,
+		"username":        name,
+		"name":            name,
+		"password":        "my-password",
+		"confirmPassword": "my-password",
+	})
+	completeResp, err := http.Post(strings.TrimSuffix(os.Getenv("GRAFANA_URL"), "/")+"/api/user/invite/complete", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer completeResp.Body.Close()
+	if completeResp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to complete the invite of %s: status %d", email, completeResp.StatusCode)
+	}
+}
@@ -0,0 +1,168 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceOrgUserID = orgResourceIDInt("userID")
+
+func resourceOrgUser() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the membership of a single user in an organization.
+
+**Note:** Memberships of an organization must not also be managed with the ` + "`admins`" + `, ` + "`editors`" + `, ` + "`viewers`" + ` and ` + "`users_without_access`" + ` attributes of ` + "`grafana_organization`" + `, or both resources will conflict.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/user-management/manage-org-users/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/org/#add-user-in-current-organization)
+`,
+
+		CreateContext: createOrgUser,
+		ReadContext:   readOrgUser,
+		UpdateContext: updateOrgUser,
+		DeleteContext: deleteOrgUser,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"login_or_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The login or email of the user. The user must already exist.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Imported memberships are read with the login of the user
+					return strings.EqualFold(old, new) || strings.EqualFold(new, d.Get("email").(string))
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The role of the user in the organization. Can be `Viewer`, `Editor`, `Admin` or `None`.",
+				ValidateFunc: validation.StringInSlice([]string{"Viewer", "Editor", "Admin", "None"}, false),
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The numerical ID of the user.",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The login of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email of the user.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_org_user",
+		resourceOrgUserID,
+		schema,
+	)
+}
+
+func createOrgUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	loginOrEmail := d.Get("login_or_email").(string)
+
+	_, err := client.Org.AddOrgUserToCurrentOrg(&models.AddOrgUserCommand{
+		LoginOrEmail: loginOrEmail,
+		Role:         d.Get("role").(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to add user %s to org %d: %s", loginOrEmail, orgID, err)
+	}
+
+	user, err := findOrgUser(client, func(user *models.OrgUserDTO) bool {
+		return strings.EqualFold(user.Login, loginOrEmail) || strings.EqualFold(user.Email, loginOrEmail)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil {
+		return diag.Errorf("user %s was added to org %d but could not be found", loginOrEmail, orgID)
+	}
+
+	d.SetId(resourceOrgUserID.Make(orgID, user.UserID))
+	return readOrgUser(ctx, d, meta)
+}
+
+func readOrgUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	userID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid org user ID %s: %s", d.Id(), err)
+	}
+
+	user, err := findOrgUser(client, func(user *models.OrgUserDTO) bool { return user.UserID == userID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil {
+		return common.WarnMissing("org user", d)
+	}
+
+	d.SetId(resourceOrgUserID.Make(orgID, userID))
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("user_id", user.UserID)
+	d.Set("login", user.Login)
+	d.Set("email", user.Email)
+	d.Set("role", user.Role)
+	if d.Get("login_or_email").(string) == "" {
+		d.Set("login_or_email", user.Login)
+	}
+
+	return nil
+}
+
+func updateOrgUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	userID, _ := strconv.ParseInt(idStr, 10, 64)
+
+	if _, err := client.Org.UpdateOrgUserForCurrentOrg(userID, &models.UpdateOrgUserCommand{Role: d.Get("role").(string)}); err != nil {
+		return diag.Errorf("failed to update the role of user %d: %s", userID, err)
+	}
+
+	return readOrgUser(ctx, d, meta)
+}
+
+func deleteOrgUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	userID, _ := strconv.ParseInt(idStr, 10, 64)
+
+	_, err := client.Org.RemoveOrgUserForCurrentOrg(userID)
+	diag, _ := common.CheckReadError("org user", d, err)
+	return diag
+}
+
+// findOrgUser returns the first user of the current org matching the filter, or nil if there is none.
+func findOrgUser(client *goapi.GrafanaHTTPAPI, filter func(user *models.OrgUserDTO) bool) (*models.OrgUserDTO, error) {
+	resp, err := client.Org.GetOrgUsersForCurrentOrg()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list org users: %w", err)
+	}
+	for _, user := range resp.Payload {
+		if filter(user) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,106 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOrgUser_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var org models.OrgDetailsDTO
+	var user models.UserProfileDTO
+	name := acctest.RandString(10)
+	replacements := func(role string) map[string]string {
+		return map[string]string{
+			`"Team"`:            `"` + name + `"`,
+			"alice@example.com": name + "@example.com",
+			`"alice"`:           `"` + name + `"`,
+			`"Editor"`:          `"` + role + `"`,
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			orgCheckExists.destroyed(&org, nil),
+			userCheckExists.destroyed(&user, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_org_user/resource.tf", replacements("Editor")),
+				Check: resource.ComposeTestCheckFunc(
+					orgCheckExists.exists("grafana_organization.team", &org),
+					userCheckExists.exists("grafana_user.alice", &user),
+					resource.TestCheckResourceAttrPair("grafana_org_user.alice", "user_id", "grafana_user.alice", "user_id"),
+					resource.TestCheckResourceAttr("grafana_org_user.alice", "login", name),
+					resource.TestCheckResourceAttr("grafana_org_user.alice", "email", name+"@example.com"),
+					resource.TestCheckResourceAttr("grafana_org_user.alice", "role", "Editor"),
+					testAccOrgUserCheckRole(&org, &user, "Editor"),
+				),
+			},
+			{
+				ResourceName:            "grafana_org_user.alice",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"login_or_email"}, // Imported with the login, configured with the email
+			},
+			// Change the role
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_org_user/resource.tf", replacements("Viewer")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_org_user.alice", "role", "Viewer"),
+					testAccOrgUserCheckRole(&org, &user, "Viewer"),
+				),
+			},
+			// Remove the membership, the user and the org are kept
+			{
+				Config: fmt.Sprintf(`
+resource "grafana_organization" "team" {
+  name = "%[1]s"
+}
+
+resource "grafana_user" "alice" {
+  email    = "%[1]s@example.com"
+  login    = "%[1]s"
+  password = "my-password"
+}
+`, name),
+				Check: testAccOrgUserCheckRole(&org, &user, ""),
+			},
+		},
+	})
+}
+
+// testAccOrgUserCheckRole checks the role of the user in the org. An empty role means the user isn't a member.
+func testAccOrgUserCheckRole(org *models.OrgDetailsDTO, user *models.UserProfileDTO, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := grafanaTestClient().Orgs.GetOrgUsers(org.ID)
+		if err != nil {
+			return err
+		}
+		for _, orgUser := range resp.Payload {
+			if orgUser.UserID != user.ID {
+				continue
+			}
+			if role == "" {
+				return fmt.Errorf("user %d is still a member of org %d", user.ID, org.ID)
+			}
+			if !strings.EqualFold(orgUser.Role, role) {
+				return fmt.Errorf("expected user %d to have role %s in org %d, got %s", user.ID, role, org.ID, orgUser.Role)
+			}
+			return nil
+		}
+		if role != "" {
+			return fmt.Errorf("user %d is not a member of org %d", user.ID, org.ID)
+		}
+		return nil
+	}
+}
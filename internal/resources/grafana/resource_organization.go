@@ -86,7 +86,8 @@ membership if they don't already exist in Grafana. If unspecified, this
 parameter defaults to true, creating placeholder users with the name, login,
 and email set to the email of the user, and a random password. Setting this
 option to false will cause an error to be thrown for any users that do not
-already exist in Grafana.
+already exist in Grafana. To invite users who don't exist yet instead, use the
+` + "`grafana_org_invite`" + ` resource.
 `,
 			},
 			"org_id": {
@@ -2,6 +2,7 @@ package grafana
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,10 +21,11 @@ func resourceRole() *common.Resource {
 * [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
 `,
-		CreateContext: CreateRole,
-		UpdateContext: UpdateRole,
+		CreateContext: common.WithRoleMutex[schema.CreateContextFunc](CreateRole),
+		UpdateContext: common.WithRoleMutex[schema.UpdateContextFunc](UpdateRole),
 		ReadContext:   ReadRole,
-		DeleteContext: DeleteRole,
+		DeleteContext: common.WithRoleMutex[schema.DeleteContextFunc](DeleteRole),
+		CustomizeDiff: validateRoleCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -84,6 +86,20 @@ func resourceRole() *common.Resource {
 				ForceNew:    true,
 				Description: "Boolean to state whether the role is available across all organizations or not.",
 			},
+			"manage_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether the permissions of the role are managed by this resource. " +
+					"Set it to `false` to manage them with `grafana_role_permission_item` resources, the `permissions` blocks must not be set then.",
+			},
+			"validate_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Validate the permissions at plan time. The actions and scopes are validated against the permissions of the roles known to Grafana, " +
+					"actions which are not granted by any existing role are rejected.",
+			},
 			"permissions": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -174,7 +190,28 @@ func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		var orgID int64 = 0
 		client = client.WithOrgID(orgID)
 	}
-	return readRoleFromUID(client, uid, d)
+	if diags := readRoleFromUID(client, uid, d); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	// The permissions of roles which don't manage them are left out of the state
+	managed := managesRolePermissions(d)
+	if !managed {
+		if err := d.Set("permissions", []interface{}{}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("manage_permissions", managed); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// managesRolePermissions returns whether the permissions of the role are managed by the resource.
+// Imported roles, and roles created by previous versions of the provider, have no value in their state and manage them.
+func managesRolePermissions(d *schema.ResourceData) bool {
+	v, ok := d.GetOkExists("manage_permissions") //nolint:staticcheck
+	return !ok || v.(bool)
 }
 
 func readRoleFromUID(client *goapi.GrafanaHTTPAPI, uid string, d *schema.ResourceData) diag.Diagnostics {
@@ -239,13 +276,24 @@ func UpdateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		client = client.WithOrgID(orgID)
 	}
 
-	if d.HasChange("version") || d.HasChange("name") || d.HasChange("description") || d.HasChange("permissions") ||
+	managed := managesRolePermissions(d)
+	if d.HasChange("version") || d.HasChange("name") || d.HasChange("description") || (managed && d.HasChange("permissions")) ||
 		d.HasChange("display_name") || d.HasChange("group") || d.HasChange("hidden") {
 		version := d.Get("version").(int)
 		if d.Get("auto_increment_version").(bool) {
 			version += 1
 		}
 
+		perms := permissions(d)
+		if !managed {
+			// The permissions are managed by grafana_role_permission_item resources, the current ones are kept
+			resp, err := client.AccessControl.GetRole(uid)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			perms = resp.Payload.Permissions
+		}
+
 		r := models.UpdateRoleCommand{
 			Name:        d.Get("name").(string),
 			Global:      d.Get("global").(bool),
@@ -254,7 +302,7 @@ func UpdateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 			Group:       d.Get("group").(string),
 			Hidden:      d.Get("hidden").(bool),
 			Version:     int64(version),
-			Permissions: permissions(d),
+			Permissions: perms,
 		}
 		if _, err := client.AccessControl.UpdateRole(uid, &r); err != nil {
 			return diag.FromErr(err)
@@ -264,6 +312,21 @@ func UpdateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	return ReadRole(ctx, d, meta)
 }
 
+func validateRoleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("manage_permissions").(bool) && d.Get("permissions").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("permissions can't be set when manage_permissions is false")
+	}
+	if !d.HasChange("permissions") || !d.NewValueKnown("permissions") || !d.NewValueKnown("org_id") || !d.Get("validate_permissions").(bool) {
+		return nil
+	}
+	var perms []*models.Permission
+	for _, permission := range d.Get("permissions").(*schema.Set).List() {
+		p := permission.(map[string]interface{})
+		perms = append(perms, &models.Permission{Action: p["action"].(string), Scope: p["scope"].(string)})
+	}
+	return validateRolePermissions(meta, d.Get("org_id").(string), perms)
+}
+
 func DeleteRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
 	global := d.Get("global").(bool)
@@ -0,0 +1,309 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var resourceRolePermissionItemID = common.NewResourceID(
+	common.IntIDField("orgID"),
+	common.StringIDField("roleUID"),
+	common.StringIDField("action"),
+	common.StringIDField("scope"),
+)
+
+func resourceRolePermissionItem() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages a single permission (an action and a scope) of a role. The permissions of the role which are not managed by this resource are kept, and the version of the role is incremented.
+
+**Note:** This resource is available only with Grafana Enterprise 8.+. When it's used, the ` + "`manage_permissions`" + ` attribute of the ` + "`grafana_role`" + ` must be set to ` + "`false`" + `.
+
+The action and the scope are validated at plan time against the permissions of the roles of the Grafana instance (fixed, plugin and custom roles).
+Actions which are not granted by any existing role are rejected, set ` + "`skip_permissions_validation`" + ` to grant them anyway.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/roles-and-permissions/access-control/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/access_control/)
+`,
+		CreateContext: common.WithRoleMutex[schema.CreateContextFunc](createRolePermissionItem),
+		ReadContext:   readRolePermissionItem,
+		// Only skip_permissions_validation can be updated, it isn't sent to Grafana
+		UpdateContext: readRolePermissionItem,
+		DeleteContext: common.WithRoleMutex[schema.DeleteContextFunc](deleteRolePermissionItem),
+		CustomizeDiff: validateRolePermissionItemCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"role_uid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UID of the role.",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Specific action users granted with the role will be allowed to perform (for example: `users:read`)",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Scope to restrict the action to a set of resources (for example: `users:*` or `roles:customrole1`)",
+			},
+			"skip_permissions_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the validation of the action and the scope at plan time.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaEnterprise,
+		"grafana_role_permission_item",
+		resourceRolePermissionItemID,
+		schema,
+	)
+}
+
+// splitRolePermissionItemID splits the ID of a role permission item. Actions contain a colon and scopes may contain colons,
+// so the ID can't be split by the generic helpers.
+func splitRolePermissionItemID(id string) (orgID int64, roleUID, action, scope string, err error) {
+	parts := strings.SplitN(id, ":", 5)
+	if len(parts) < 4 {
+		return 0, "", "", "", fmt.Errorf("id %q does not match expected format. Should be in the format: orgID:roleUID:action:scope", id)
+	}
+	if orgID, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return 0, "", "", "", fmt.Errorf("expected int for field %q, got %q", "orgID", parts[0])
+	}
+	if len(parts) == 5 {
+		scope = parts[4]
+	}
+	return orgID, parts[1], parts[2] + ":" + parts[3], scope, nil
+}
+
+// getRoleForPermissions returns a role and a client for its org. Global roles are read and updated without org.
+func getRoleForPermissions(client *goapi.GrafanaHTTPAPI, roleUID string) (*goapi.GrafanaHTTPAPI, *models.RoleDTO, error) {
+	resp, err := client.AccessControl.GetRole(roleUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Payload.Global {
+		client = client.Clone().WithOrgID(0)
+	}
+	return client, resp.Payload, nil
+}
+
+// updateRolePermissions replaces the permissions of a role, incrementing its version.
+func updateRolePermissions(client *goapi.GrafanaHTTPAPI, role *models.RoleDTO, permissions []*models.Permission) error {
+	_, err := client.AccessControl.UpdateRole(role.UID, &models.UpdateRoleCommand{
+		Name:        role.Name,
+		Global:      role.Global,
+		Description: role.Description,
+		DisplayName: role.DisplayName,
+		Group:       role.Group,
+		Hidden:      role.Hidden,
+		Version:     role.Version + 1,
+		Permissions: permissions,
+	})
+	return err
+}
+
+func createRolePermissionItem(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	roleUID, action, scope := d.Get("role_uid").(string), d.Get("action").(string), d.Get("scope").(string)
+
+	client, role, err := getRoleForPermissions(client, roleUID)
+	if err != nil {
+		return diag.Errorf("failed to get role %s: %s", roleUID, err)
+	}
+	if role.Global {
+		orgID = 0
+	}
+
+	found := false
+	for _, permission := range role.Permissions {
+		if permission.Action == action && permission.Scope == scope {
+			found = true
+			break
+		}
+	}
+	if !found {
+		permissions := append(role.Permissions, &models.Permission{Action: action, Scope: scope})
+		if err := updateRolePermissions(client, role, permissions); err != nil {
+			return diag.Errorf("failed to add permission to role %s: %s", roleUID, err)
+		}
+	}
+
+	d.SetId(resourceRolePermissionItemID.Make(orgID, roleUID, action, scope))
+	return readRolePermissionItem(ctx, d, meta)
+}
+
+func readRolePermissionItem(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, roleUID, action, scope, err := splitRolePermissionItemID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+
+	resp, err := client.AccessControl.GetRole(roleUID)
+	if err, shouldReturn := common.CheckReadError("role", d, err); shouldReturn {
+		return err
+	}
+
+	for _, permission := range resp.Payload.Permissions {
+		if permission.Action == action && permission.Scope == scope {
+			if !resp.Payload.Global {
+				d.Set("org_id", strconv.FormatInt(orgID, 10))
+			}
+			d.Set("role_uid", roleUID)
+			d.Set("action", action)
+			d.Set("scope", scope)
+			return nil
+		}
+	}
+
+	return common.WarnMissing("role permission", d)
+}
+
+func deleteRolePermissionItem(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	orgID, roleUID, action, scope, err := splitRolePermissionItemID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+
+	resp, err := client.AccessControl.GetRole(roleUID)
+	if err, shouldReturn := common.CheckReadError("role", d, err); shouldReturn {
+		return err
+	}
+	role := resp.Payload
+
+	permissions := make([]*models.Permission, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		if permission.Action != action || permission.Scope != scope {
+			permissions = append(permissions, permission)
+		}
+	}
+	if len(permissions) == len(role.Permissions) {
+		return nil
+	}
+
+	if err := updateRolePermissions(client, role, permissions); err != nil {
+		return diag.Errorf("failed to remove permission from role %s: %s", roleUID, err)
+	}
+	return nil
+}
+
+func validateRolePermissionItemCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || d.Get("skip_permissions_validation").(bool) || !d.NewValueKnown("action") || !d.NewValueKnown("scope") || !d.NewValueKnown("org_id") {
+		return nil
+	}
+	permissions := []*models.Permission{{Action: d.Get("action").(string), Scope: d.Get("scope").(string)}}
+	return validateRolePermissions(meta, d.Get("org_id").(string), permissions)
+}
+
+// rolePermissionCatalogKey identifies the permission catalog of an org of a Grafana instance.
+type rolePermissionCatalogKey struct {
+	client *common.Client
+	orgID  int64
+}
+
+// rolePermissionCatalogs caches the permission catalogs, since they are used to validate each permission at plan time.
+var rolePermissionCatalogs sync.Map
+
+// getRolePermissionCatalog returns the actions which can be granted, with the roots of their scopes (ex: `folders` for `folders:uid:abc`).
+// The catalog is built from the permissions of all the roles of the instance, including fixed and plugin roles.
+// No catalog is returned when the Grafana client isn't configured, the provider reports it when the resources are applied.
+func getRolePermissionCatalog(meta interface{}, orgIDStr string) (map[string]map[string]bool, error) {
+	key := rolePermissionCatalogKey{client: meta.(*common.Client)}
+	if key.client.GrafanaAPI == nil {
+		return nil, nil
+	}
+	key.orgID, _ = strconv.ParseInt(orgIDStr, 10, 64)
+	if catalog, ok := rolePermissionCatalogs.Load(key); ok {
+		return catalog.(map[string]map[string]bool), nil
+	}
+
+	client := key.client.GrafanaAPI.Clone()
+	if key.orgID > 0 {
+		client = client.WithOrgID(key.orgID)
+	}
+	includeHidden := true
+	resp, err := client.AccessControl.ListRoles(access_control.NewListRolesParams().WithIncludeHidden(&includeHidden))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles to validate permissions: %w", err)
+	}
+
+	catalog := map[string]map[string]bool{}
+	for _, role := range resp.Payload {
+		for _, permission := range role.Permissions {
+			if catalog[permission.Action] == nil {
+				catalog[permission.Action] = map[string]bool{}
+			}
+			if root := rolePermissionScopeRoot(permission.Scope); root != "" {
+				catalog[permission.Action][root] = true
+			}
+		}
+	}
+	rolePermissionCatalogs.Store(key, catalog)
+	return catalog, nil
+}
+
+func rolePermissionScopeRoot(scope string) string {
+	root, _, _ := strings.Cut(scope, ":")
+	return root
+}
+
+// validateRolePermissions checks that the actions of the permissions are known to Grafana, and that their scopes apply to the kind of resources of the action.
+func validateRolePermissions(meta interface{}, orgID string, permissions []*models.Permission) error {
+	catalog, err := getRolePermissionCatalog(meta, orgID)
+	if err != nil {
+		return err
+	}
+	// Roles are listed without their permissions by some versions of Grafana, nothing can be validated then
+	if len(catalog) == 0 {
+		return nil
+	}
+
+	var problems []string
+	for _, permission := range permissions {
+		roots, ok := catalog[permission.Action]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown action %q", permission.Action))
+			continue
+		}
+		root := rolePermissionScopeRoot(permission.Scope)
+		if permission.Scope == "" || permission.Scope == "*" || len(roots) == 0 || roots[root] {
+			continue
+		}
+		expected := make([]string, 0, len(roots))
+		for r := range roots {
+			expected = append(expected, r+":")
+		}
+		sort.Strings(expected)
+		problems = append(problems, fmt.Sprintf("scope %q doesn't apply to action %q, expected a scope starting with %s", permission.Scope, permission.Action, strings.Join(expected, ", ")))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid permissions:\n  - %s", strings.Join(problems, "\n  - "))
+}
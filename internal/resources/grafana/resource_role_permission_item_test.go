@@ -0,0 +1,124 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRolePermissionItem_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	var role models.RoleDTO
+	name := acctest.RandomWithPrefix("role-permission-item")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             roleCheckExists.destroyed(&role, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePermissionItemConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					roleCheckExists.exists("grafana_role.test", &role),
+					resource.TestCheckResourceAttr("grafana_role_permission_item.users", "id", "1:"+name+":org.users:read:users:*"),
+					resource.TestCheckResourceAttr("grafana_role_permission_item.users", "scope", "users:*"),
+					resource.TestCheckResourceAttr("grafana_role_permission_item.folders", "id", "1:"+name+":folders:read:folders:*"),
+					checkRolePermissionCount(&role, 2),
+				),
+			},
+			{
+				ResourceName:      "grafana_role_permission_item.users",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing an item keeps the other permissions of the role
+			{
+				Config: testAccRolePermissionItemConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					roleCheckExists.exists("grafana_role.test", &role),
+					checkRolePermissionCount(&role, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolePermissionItem_invalid(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "grafana_role_permission_item" "test" {
+	role_uid = "unknown"
+	action   = "users:fly"
+}`,
+				ExpectError: regexp.MustCompile(`unknown action "users:fly"`),
+			},
+			{
+				Config: `
+resource "grafana_role_permission_item" "test" {
+	role_uid = "unknown"
+	action   = "folders:read"
+	scope    = "dashboards:*"
+}`,
+				ExpectError: regexp.MustCompile(`scope "dashboards:\*" doesn't apply to action "folders:read"`),
+			},
+			// The validation can be skipped for actions which aren't granted by any role
+			{
+				Config: `
+resource "grafana_role_permission_item" "test" {
+	role_uid                    = "unknown"
+	action                      = "users:fly"
+	skip_permissions_validation = true
+}`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func checkRolePermissionCount(role *models.RoleDTO, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(role.Permissions) != expected {
+			return fmt.Errorf("expected %d permissions on role %s, got %d", expected, role.UID, len(role.Permissions))
+		}
+		return nil
+	}
+}
+
+func testAccRolePermissionItemConfig(name string, withFolders bool) string {
+	config := fmt.Sprintf(`
+resource "grafana_role" "test" {
+	name                   = "%[1]s"
+	uid                    = "%[1]s"
+	auto_increment_version = true
+	manage_permissions     = false
+}
+
+resource "grafana_role_permission_item" "users" {
+	role_uid = grafana_role.test.uid
+	action   = "org.users:read"
+	scope    = "users:*"
+}
+`, name)
+	if withFolders {
+		config += `
+resource "grafana_role_permission_item" "folders" {
+	role_uid = grafana_role.test.uid
+	action   = "folders:read"
+	scope    = "folders:*"
+}
+`
+	}
+	return config
+}
@@ -2,6 +2,7 @@ package grafana_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -53,6 +54,27 @@ func TestAccRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("grafana_role.test", "permissions.1.action", "users:read"),
 				),
 			},
+			// Removing all the permissions blocks removes the permissions of the role
+			{
+				Config: strings.ReplaceAll(roleConfigBasic, "version = 1", "version = 3"),
+				Check: resource.ComposeTestCheckFunc(
+					roleCheckExists.exists("grafana_role.test", &role),
+					resource.TestCheckResourceAttr("grafana_role.test", "version", "3"),
+					resource.TestCheckResourceAttr("grafana_role.test", "permissions.#", "0"),
+					checkRolePermissionCount(&role, 0),
+				),
+			},
+			// Actions which no role grants are only rejected when the validation is enabled
+			{
+				Config:             strings.ReplaceAll(roleConfigWithPermissions, `"users:create"`, `"users:fly"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      strings.NewReplacer(`"users:create"`, `"users:fly"`, "hidden = true", "hidden = true\n  validate_permissions = true").Replace(roleConfigWithPermissions),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unknown action "users:fly"`),
+			},
 		},
 	})
 }
@@ -0,0 +1,140 @@
+package grafana
+
+import (
+	"context"
+
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverSettingRedactedValue is returned by Grafana instead of the value of the secret settings.
+const serverSettingRedactedValue = "*********"
+
+var resourceServerSettingID = common.NewResourceID(
+	common.StringIDField("section"),
+	common.StringIDField("key"),
+)
+
+func resourceServerSetting() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages a setting of the Grafana server which can be updated at runtime. The value overrides the one of the configuration file, it is reverted when the resource is destroyed.
+
+**Note:** This resource is available only with Grafana Enterprise 8.+. Only some settings can be updated at runtime (ex: the settings of the ` + "`auth.saml`" + ` section), Grafana rejects the others.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-grafana/settings-updates-at-runtime/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/admin/#update-settings)
+`,
+		CreateContext: createServerSetting,
+		ReadContext:   readServerSetting,
+		UpdateContext: updateServerSetting,
+		DeleteContext: deleteServerSetting,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"section": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The section of the setting, as in the configuration file (for example: `auth.saml`).",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The key of the setting in the section (for example: `enabled`).",
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				Description: "The value of the setting. It is sensitive since some sections hold secrets (for example: the `private_key` of `auth.saml`). " +
+					"Secret values are redacted by Grafana, so their changes made outside of Terraform aren't detected.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaEnterprise,
+		"grafana_server_setting",
+		resourceServerSettingID,
+		schema,
+	)
+}
+
+// serverSettingsUpdate is the body of the settings update endpoint, which isn't covered by the OpenAPI client.
+type serverSettingsUpdate struct {
+	Updates  map[string]map[string]string `json:"updates,omitempty"`
+	Removals map[string][]string          `json:"removals,omitempty"`
+}
+
+func createServerSetting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	section, key := d.Get("section").(string), d.Get("key").(string)
+	if err := putServerSetting(ctx, meta, section, key, d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceServerSettingID.Make(section, key))
+	return readServerSetting(ctx, d, meta)
+}
+
+func readServerSetting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	split, err := resourceServerSettingID.Split(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	section, key := split[0].(string), split[1].(string)
+
+	client, err := OAPIGlobalClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Admin.AdminGetSettings()
+	if err, shouldReturn := common.CheckReadError("server settings", d, err); shouldReturn {
+		return err
+	}
+	value, ok := resp.Payload[section][key]
+	if !ok {
+		return common.WarnMissing("server setting", d)
+	}
+
+	d.Set("section", section)
+	d.Set("key", key)
+	if value != serverSettingRedactedValue {
+		d.Set("value", value)
+	}
+	return nil
+}
+
+func updateServerSetting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putServerSetting(ctx, meta, d.Get("section").(string), d.Get("key").(string), d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return readServerSetting(ctx, d, meta)
+}
+
+func deleteServerSetting(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := OAPIGlobalClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	section, key := d.Get("section").(string), d.Get("key").(string)
+
+	// Removing the setting reverts it to the value of the configuration file
+	body := serverSettingsUpdate{Removals: map[string][]string{section: {key}}}
+	err = grafanaAPIRequest(ctx, client, "PUT", "/admin/settings", body, nil)
+	diag, _ := common.CheckReadError("server setting", d, err)
+	return diag
+}
+
+func putServerSetting(ctx context.Context, meta interface{}, section, key, value string) error {
+	client, err := OAPIGlobalClient(meta)
+	if err != nil {
+		return err
+	}
+	body := serverSettingsUpdate{Updates: map[string]map[string]string{section: {key: value}}}
+	return grafanaAPIRequest(ctx, client, "PUT", "/admin/settings", body, nil)
+}
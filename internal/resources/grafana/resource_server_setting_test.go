@@ -0,0 +1,66 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccServerSetting_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t, ">=9.0.0")
+
+	api := grafanaTestClient()
+	settings, err := api.Admin.AdminGetSettings()
+	if err != nil {
+		t.Fatalf("failed to fetch the server settings: %v", err)
+	}
+	defaultValue := settings.Payload["auth.saml"]["single_logout"]
+
+	config := testutils.TestAccExample(t, "resources/grafana_server_setting/resource.tf")
+
+	// The settings are global, the test can't run in parallel with other tests updating them
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             checkServerSetting(api, "auth.saml", "single_logout", defaultValue),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_server_setting.saml_single_logout", "id", "auth.saml:single_logout"),
+					resource.TestCheckResourceAttr("grafana_server_setting.saml_single_logout", "value", "true"),
+					checkServerSetting(api, "auth.saml", "single_logout", "true"),
+				),
+			},
+			{
+				Config: strings.ReplaceAll(config, `"true"`, `"false"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_server_setting.saml_single_logout", "value", "false"),
+					checkServerSetting(api, "auth.saml", "single_logout", "false"),
+				),
+			},
+			{
+				ResourceName:      "grafana_server_setting.saml_single_logout",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkServerSetting(api *client.GrafanaHTTPAPI, section, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := api.Admin.AdminGetSettings()
+		if err != nil {
+			return err
+		}
+		if value := resp.Payload[section][key]; value != expected {
+			return fmt.Errorf("expected setting %s.%s to be %q, got %q", section, key, expected, value)
+		}
+		return nil
+	}
+}
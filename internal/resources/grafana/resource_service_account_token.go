@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -21,7 +22,9 @@ func resourceServiceAccountToken() *common.Resource {
 
 		CreateContext: serviceAccountTokenCreate,
 		ReadContext:   serviceAccountTokenRead,
+		UpdateContext: serviceAccountTokenUpdate,
 		DeleteContext: serviceAccountTokenDelete,
+		CustomizeDiff: common.ServiceAccountTokenRotationCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,6 +63,7 @@ func resourceServiceAccountToken() *common.Resource {
 			},
 		},
 	}
+	common.AddServiceAccountTokenRotationSchema(schema.Schema)
 
 	return common.NewLegacySDKResource(
 		common.CategoryGrafanaOSS,
@@ -96,11 +100,62 @@ func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.Set("created_at", time.Now().UTC().Format(time.RFC3339))
 
 	// Fill the true resource's state by performing a read
 	return serviceAccountTokenRead(ctx, d, m)
 }
 
+func serviceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
+	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The planned values of rotated attributes are unknown, so the previous values are read from the state
+	oldKey, _ := d.GetChange("key")
+	oldPreviousTokenID, _ := d.GetChange("previous_token_id")
+	if !d.HasChange("key") && !d.HasChange("previous_token_id") {
+		return serviceAccountTokenRead(ctx, d, m)
+	}
+
+	// The previous token is deleted once its overlap period is over, or when the token is rotated again
+	if previousTokenID := oldPreviousTokenID.(string); previousTokenID != "" {
+		id, err := strconv.ParseInt(previousTokenID, 10, 64)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := c.ServiceAccounts.DeleteToken(id, serviceAccountID); err != nil && !common.IsNotFoundError(err) {
+			return diag.Errorf("failed to delete previous token %d: %s", id, err)
+		}
+		d.Set("previous_key", "")
+		d.Set("previous_token_id", "")
+	}
+
+	if d.HasChange("key") {
+		createdAt := time.Now().UTC()
+		request := models.AddServiceAccountTokenCommand{
+			Name:          common.RotatedServiceAccountTokenName(d.Get("name").(string), createdAt),
+			SecondsToLive: int64(d.Get("seconds_to_live").(int)),
+		}
+		params := service_accounts.NewCreateTokenParams().WithServiceAccountID(serviceAccountID).WithBody(&request)
+		response, err := c.ServiceAccounts.CreateToken(params)
+		if err != nil {
+			return diag.Errorf("failed to rotate token: %s", err)
+		}
+
+		d.Set("previous_key", oldKey)
+		d.Set("previous_token_id", d.Id())
+		d.SetId(strconv.FormatInt(response.Payload.ID, 10))
+		d.Set("key", response.Payload.Key)
+		d.Set("created_at", createdAt.Format(time.RFC3339))
+	}
+
+	return serviceAccountTokenRead(ctx, d, m)
+}
+
 func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
 	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
@@ -121,9 +176,15 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m inte
 	for _, key := range response.Payload {
 		if id == key.ID {
 			d.SetId(strconv.FormatInt(key.ID, 10))
-			err = d.Set("name", key.Name)
-			if err != nil {
-				return diag.FromErr(err)
+			// Rotated tokens are named after the configured name
+			if !common.IsRotatedServiceAccountTokenName(d, key.Name) {
+				err = d.Set("name", key.Name)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			if d.Get("created_at").(string) == "" && !key.Created.IsZero() {
+				d.Set("created_at", time.Time(key.Created).UTC().Format(time.RFC3339))
 			}
 			if !key.Expiration.IsZero() {
 				err = d.Set("expiration", key.Expiration.String())
@@ -154,6 +215,18 @@ func serviceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	_, err = c.ServiceAccounts.DeleteToken(id, serviceAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	if previousTokenID := d.Get("previous_token_id").(string); previousTokenID != "" {
+		id, err := strconv.ParseInt(previousTokenID, 10, 64)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := c.ServiceAccounts.DeleteToken(id, serviceAccountID); err != nil && !common.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
 }
@@ -2,7 +2,9 @@ package grafana_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
@@ -110,6 +112,117 @@ func TestAccServiceAccountToken_inOrg(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountToken_rotation(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	name := acctest.RandString(10)
+	var sa models.ServiceAccountDTO
+	config := testAccServiceAccountTokenConfig(name, "Editor", 0, false)
+	rotationConfig := func(rotation string) string {
+		return regexp.MustCompile(`(resource "grafana_service_account_token" "test" \{)`).ReplaceAllString(config, "$1\n\trotation {\n"+rotation+"\n\t}")
+	}
+	var firstKey, secondKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             serviceAccountCheckExists.destroyed(&sa, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: rotationConfig(`rotate_after = "4s"`),
+				Check: resource.ComposeTestCheckFunc(
+					serviceAccountCheckExists.exists("grafana_service_account.test", &sa),
+					checkServiceAccountTokens(&sa, []string{name}),
+					resource.TestCheckResourceAttrSet("grafana_service_account_token.test", "created_at"),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "previous_key", ""),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "previous_token_id", ""),
+					resource.TestCheckResourceAttrWith("grafana_service_account_token.test", "key", func(value string) error {
+						firstKey = value
+						return nil
+					}),
+				),
+			},
+			// The token is rotated once older than rotate_after, the previous token is kept
+			{
+				PreConfig: func() { time.Sleep(5 * time.Second) },
+				Config:    rotationConfig(`rotate_after = "4s"`),
+				Check: resource.ComposeTestCheckFunc(
+					checkServiceAccountTokenCount(&sa, 2),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "name", name),
+					resource.TestCheckResourceAttrSet("grafana_service_account_token.test", "previous_token_id"),
+					resource.TestCheckResourceAttrWith("grafana_service_account_token.test", "previous_key", func(value string) error {
+						if value != firstKey {
+							return fmt.Errorf("expected the previous key to be the first key")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("grafana_service_account_token.test", "key", func(value string) error {
+						if value == firstKey {
+							return fmt.Errorf("expected the key to be rotated")
+						}
+						secondKey = value
+						return nil
+					}),
+				),
+				// Without overlap, the previous token is deleted by the next apply
+				ExpectNonEmptyPlan: true,
+			},
+			// The longer rotate_after keeps the token from being rotated again while the previous token is deleted
+			{
+				Config: rotationConfig(`rotate_after = "1h"`),
+				Check: resource.ComposeTestCheckFunc(
+					checkServiceAccountTokenCount(&sa, 1),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "previous_key", ""),
+					resource.TestCheckResourceAttr("grafana_service_account_token.test", "previous_token_id", ""),
+					resource.TestCheckResourceAttrWith("grafana_service_account_token.test", "key", func(value string) error {
+						if value != secondKey {
+							return fmt.Errorf("expected the key to be kept")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceAccountToken_rotationLongerThanTTL(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "grafana_service_account_token" "test" {
+	name               = "test"
+	service_account_id = "1"
+	seconds_to_live    = 3600
+
+	rotation {
+		rotate_after = "1h"
+		overlap      = "10m"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must not exceed seconds_to_live"),
+			},
+		},
+	})
+}
+
+func checkServiceAccountTokenCount(sa *models.ServiceAccountDTO, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := grafanaTestClient().WithOrgID(sa.OrgID).ServiceAccounts.ListTokens(sa.ID)
+		if err != nil {
+			return err
+		}
+		if len(resp.Payload) != expected {
+			return fmt.Errorf("expected %d tokens, got %d", expected, len(resp.Payload))
+		}
+		return nil
+	}
+}
+
 func checkServiceAccountTokens(sa *models.ServiceAccountDTO, expectNames []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := grafanaTestClient().WithOrgID(sa.OrgID)
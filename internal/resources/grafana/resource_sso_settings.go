@@ -2,15 +2,21 @@ package grafana
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmespath/go-jmespath"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
 )
@@ -29,6 +35,9 @@ func resourceSSOSettings() *common.Resource {
 		Description: `
 Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is currently in preview, it will be available in Grafana starting with v11.3.
 
+The secrets (` + "`client_secret`" + `, ` + "`certificate`" + `, ` + "`private_key`" + `, ` + "`bind_password`" + `, ` + "`client_key`" + ` and ` + "`client_key_value`" + `) are write-only: only their SHA-256 hash is stored in the state, and they are sent to Grafana only when they change. The secrets stored in the state by earlier versions of the provider are replaced by their hash.
+The fields required by each provider and the JMESPath expressions are validated at plan time.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/setup-grafana/configure-security/configure-authentication/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/sso-settings/)
 `,
@@ -37,6 +46,8 @@ Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is curr
 		ReadContext:   ReadSSOSettings,
 		UpdateContext: UpdateSSOSettings,
 		DeleteContext: DeleteSSOSettings,
+		CustomizeDiff: validateSSOSettingsCustomizeDiff,
+		SchemaVersion: 1,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -55,6 +66,7 @@ Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is curr
 				MinItems:      0,
 				Description:   "The OAuth2 settings set. Required for github, gitlab, google, azuread, okta, generic_oauth providers.",
 				Elem:          oauth2SettingsSchema,
+				Set:           hashSSOSettings(oauth2SettingsSchema),
 				ConflictsWith: []string{samlSettingsKey, ldapSettingsKey},
 			},
 			samlSettingsKey: {
@@ -64,6 +76,7 @@ Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is curr
 				MinItems:      0,
 				Description:   "The SAML settings set. Required for the saml provider.",
 				Elem:          samlSettingsSchema,
+				Set:           hashSSOSettings(samlSettingsSchema),
 				ConflictsWith: []string{oauth2SettingsKey, ldapSettingsKey},
 			},
 			ldapSettingsKey: {
@@ -73,11 +86,14 @@ Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is curr
 				MinItems:      0,
 				Description:   "The LDAP settings set. Required for the ldap provider.",
 				Elem:          ldapSettingsSchema,
+				Set:           hashSSOSettings(ldapSettingsSchema),
 				ConflictsWith: []string{oauth2SettingsKey, samlSettingsKey},
 			},
 		},
 	}
 
+	schema.StateUpgraders = ssoSettingsStateUpgraders(schema)
+
 	return common.NewLegacySDKResource(
 		common.CategoryGrafanaOSS,
 		"grafana_sso_settings",
@@ -104,6 +120,7 @@ var oauth2SettingsSchema = &schema.Resource{
 			Optional:    true,
 			Sensitive:   true,
 			Description: "The client secret of your OAuth2 app.",
+			StateFunc:   hashSSOSecret,
 		},
 		"allowed_organizations": {
 			Type:        schema.TypeString,
@@ -151,9 +168,10 @@ var oauth2SettingsSchema = &schema.Resource{
 			Description: "The user information endpoint of your OAuth2 provider. Required for okta and generic_oauth providers.",
 		},
 		"role_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JMESPath expression to use for Grafana role lookup.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "JMESPath expression to use for Grafana role lookup.",
+			ValidateFunc: validateJMESPath,
 		},
 		"name": {
 			Type:        schema.TypeString,
@@ -181,19 +199,22 @@ var oauth2SettingsSchema = &schema.Resource{
 			Description: "Name of the key to use for user email lookup within the attributes map of OAuth2 ID token. Only applicable to Generic OAuth.",
 		},
 		"email_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JMESPath expression to use for user email lookup from the user information. Only applicable to Generic OAuth.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "JMESPath expression to use for user email lookup from the user information. Only applicable to Generic OAuth.",
+			ValidateFunc: validateJMESPath,
 		},
 		"name_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JMESPath expression to use for user name lookup from the user ID token. This name will be used as the user’s display name. Only applicable to Generic OAuth.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "JMESPath expression to use for user name lookup from the user ID token. This name will be used as the user’s display name. Only applicable to Generic OAuth.",
+			ValidateFunc: validateJMESPath,
 		},
 		"login_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JMESPath expression to use for user login lookup from the user ID token. Only applicable to Generic OAuth.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "JMESPath expression to use for user login lookup from the user ID token. Only applicable to Generic OAuth.",
+			ValidateFunc: validateJMESPath,
 		},
 		"id_token_attribute_name": {
 			Type:        schema.TypeString,
@@ -221,9 +242,10 @@ var oauth2SettingsSchema = &schema.Resource{
 			Description: "List of comma- or space-separated Organization:OrgIdOrOrgName:Role mappings. Organization can be * meaning “All users”. Role is optional and can have the following values: None, Viewer, Editor or Admin.",
 		},
 		"org_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: `JMESPath expression to use for the organization mapping lookup from the user ID token. The extracted list will be used for the organization mapping (to match "Organization" in the "org_mapping"). Only applicable to Generic OAuth and Okta.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  `JMESPath expression to use for the organization mapping lookup from the user ID token. The extracted list will be used for the organization mapping (to match "Organization" in the "org_mapping"). Only applicable to Generic OAuth and Okta.`,
+			ValidateFunc: validateJMESPath,
 		},
 		"define_allowed_groups": {
 			Type:        schema.TypeBool,
@@ -266,9 +288,10 @@ var oauth2SettingsSchema = &schema.Resource{
 			Description: "If enabled, the client accepts any certificate presented by the server and any host name in that certificate. You should only use this for testing, because this mode leaves SSL/TLS susceptible to man-in-the-middle attacks.",
 		},
 		"groups_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "JMESPath expression to use for user group lookup. If you configure allowed_groups, you must also configure groups_attribute_path.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "JMESPath expression to use for user group lookup. If you configure allowed_groups, you must also configure groups_attribute_path.",
+			ValidateFunc: validateJMESPath,
 		},
 		"teams_url": {
 			Type:        schema.TypeString,
@@ -276,9 +299,10 @@ var oauth2SettingsSchema = &schema.Resource{
 			Description: "The URL used to query for Team Ids. If not set, the default value is /teams. If you configure teams_url, you must also configure team_ids_attribute_path. Only applicable to Generic OAuth.",
 		},
 		"team_ids_attribute_path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The JMESPath expression to use for Grafana Team Id lookup within the results returned by the teams_url endpoint. Only applicable to Generic OAuth.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The JMESPath expression to use for Grafana Team Id lookup within the results returned by the teams_url endpoint. Only applicable to Generic OAuth.",
+			ValidateFunc: validateJMESPath,
 		},
 		"team_ids": {
 			Type:        schema.TypeString,
@@ -339,6 +363,7 @@ var samlSettingsSchema = &schema.Resource{
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Base64-encoded string for the SP X.509 certificate.",
+			StateFunc:   hashSSOSecret,
 		},
 		"certificate_path": {
 			Type:        schema.TypeString,
@@ -350,6 +375,7 @@ var samlSettingsSchema = &schema.Resource{
 			Optional:    true,
 			Sensitive:   true,
 			Description: "Base64-encoded string for the SP private key.",
+			StateFunc:   hashSSOSecret,
 		},
 		"private_key_path": {
 			Type:        schema.TypeString,
@@ -476,6 +502,7 @@ var samlSettingsSchema = &schema.Resource{
 			Optional: true,
 			// Sensitive:   true,
 			Description: "The client secret of your OAuth2 app.",
+			StateFunc:   hashSSOSecret,
 		},
 		"token_url": {
 			Type:        schema.TypeString,
@@ -589,12 +616,14 @@ var ldapSettingsSchema = &schema.Resource{
 									Optional:    true,
 									Sensitive:   true,
 									Description: "The path to the client private key.",
+									StateFunc:   hashSSOSecret,
 								},
 								"client_key_value": {
 									Type:        schema.TypeString,
 									Optional:    true,
 									Sensitive:   true,
 									Description: "The Base64 encoded value of the client private key.",
+									StateFunc:   hashSSOSecret,
 								},
 								"bind_dn": {
 									Type:        schema.TypeString,
@@ -606,6 +635,7 @@ var ldapSettingsSchema = &schema.Resource{
 									Optional:    true,
 									Sensitive:   true,
 									Description: "The search user bind password.",
+									StateFunc:   hashSSOSecret,
 								},
 								"timeout": {
 									Type:        schema.TypeInt,
@@ -652,6 +682,11 @@ var ldapSettingsSchema = &schema.Resource{
 										Type: schema.TypeString,
 									},
 								},
+								"ignore_group_mappings": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "If set to true, the group mappings of the server are not managed by this resource, they can then be managed with `grafana_ldap_group_mapping` resources. `group_mappings` must not be set then.",
+								},
 								"group_mappings": {
 									Type:        schema.TypeList,
 									Optional:    true,
@@ -791,8 +826,21 @@ func UpdateSSOSettings(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
+	if err := setSSOSecretsForAPI(d, provider, settingsKey, settings); err != nil {
+		return diag.FromErr(err)
+	}
+
 	settings = getSettingsForAPI(provider, settings)
 
+	if isLdapProvider(provider) {
+		ldapSettingsMutex.Lock()
+		defer ldapSettingsMutex.Unlock()
+
+		if err := keepUnmanagedLdapGroupMappings(client, settings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if isOAuth2Provider(provider) {
 		diags := validateOAuth2CustomFields(settings)
 		if diags != nil {
@@ -921,21 +969,52 @@ func getSettingsWithSecretsForLdap(state any, config any) any {
 
 	for i, serverRaw := range configServers {
 		server := serverRaw.(map[string]any)
-		for _, field := range secretFields {
-			if len(stateServers) < i+1 {
-				continue
-			}
+		if len(stateServers) < i+1 {
+			continue
+		}
+		stateServer := stateServers[i].(map[string]any)
 
-			secret, ok := stateServers[i].(map[string]any)[field].(string)
+		for _, field := range secretFields {
+			secret, ok := stateServer[field].(string)
 			if ok {
 				server[field] = secret
 			}
 		}
+
+		// ignore_group_mappings isn't stored by the API, and the ignored group mappings are left out of the state
+		if ignore, _ := stateServer["ignore_group_mappings"].(bool); ignore {
+			server["ignore_group_mappings"] = true
+			server["group_mappings"] = []any{}
+		}
 	}
 
 	return config
 }
 
+// keepUnmanagedLdapGroupMappings sets the current group mappings of the LDAP servers with ignore_group_mappings,
+// so that the mappings managed by grafana_ldap_group_mapping resources are not removed. The flag isn't sent to the API.
+func keepUnmanagedLdapGroupMappings(client *goapi.GrafanaHTTPAPI, settings map[string]any) error {
+	config, _ := settings["config"].(map[string]any)
+	servers, _ := config["servers"].([]any)
+	for _, item := range servers {
+		server := item.(map[string]any)
+		ignore, _ := server["ignore_group_mappings"].(bool)
+		delete(server, "ignore_group_mappings")
+		if !ignore {
+			continue
+		}
+
+		_, currentServer, err := getLDAPServer(client, server["host"].(string))
+		if err != nil {
+			return err
+		}
+		if currentServer != nil {
+			server["group_mappings"] = currentServer["group_mappings"]
+		}
+	}
+	return nil
+}
+
 func getSettingsForTF(payload *models.GetProviderSettingsOKBody) (map[string]any, error) {
 	settings, ok := payload.Settings.(map[string]any)
 	if !ok {
@@ -968,10 +1047,12 @@ func getSettingsForAPI(provider string, settings map[string]any) map[string]any
 }
 
 func getSettingsFromResourceData(d *schema.ResourceData, settingsKey string) (map[string]any, error) {
-	settingsList := d.Get(settingsKey).(*schema.Set).List()
+	return getSettingsFromList(d.Get(settingsKey).(*schema.Set).List(), d.Get(providerKey).(string))
+}
 
+func getSettingsFromList(settingsList []any, provider string) (map[string]any, error) {
 	if len(settingsList) == 0 {
-		return nil, fmt.Errorf("no settings found for the provider %s", d.Get(providerKey).(string))
+		return nil, fmt.Errorf("no settings found for the provider %s", provider)
 	}
 
 	if len(settingsList) == 1 {
@@ -1003,7 +1084,7 @@ func getSettingsFromResourceData(d *schema.ResourceData, settingsKey string) (ma
 		}
 	}
 
-	return nil, fmt.Errorf("no valid settings found for the provider %s", d.Get(providerKey).(string))
+	return nil, fmt.Errorf("no valid settings found for the provider %s", provider)
 }
 
 type validateFunc func(settingsMap map[string]any, provider string) error
@@ -1025,6 +1106,7 @@ var validationsByProvider = map[string][]validateFunc{
 		ssoValidateURL("token_url"),
 		ssoValidateURL("api_url"),
 		ssoValidateInterdependencyXOR("org_attribute_path", "org_mapping"),
+		ssoValidateRoleAttributeStrict(),
 	},
 	"okta": {
 		ssoValidateNotEmpty("auth_url"),
@@ -1034,24 +1116,28 @@ var validationsByProvider = map[string][]validateFunc{
 		ssoValidateURL("token_url"),
 		ssoValidateURL("api_url"),
 		ssoValidateInterdependencyXOR("org_attribute_path", "org_mapping"),
+		ssoValidateRoleAttributeStrict(),
 	},
 	"github": {
 		ssoValidateEmpty("auth_url"),
 		ssoValidateEmpty("token_url"),
 		ssoValidateEmpty("api_url"),
 		ssoValidateEmpty("org_attribute_path"),
+		ssoValidateRoleAttributeStrict(),
 	},
 	"gitlab": {
 		ssoValidateEmpty("auth_url"),
 		ssoValidateEmpty("token_url"),
 		ssoValidateEmpty("api_url"),
 		ssoValidateEmpty("org_attribute_path"),
+		ssoValidateRoleAttributeStrict(),
 	},
 	"google": {
 		ssoValidateEmpty("auth_url"),
 		ssoValidateEmpty("token_url"),
 		ssoValidateEmpty("api_url"),
 		ssoValidateEmpty("org_attribute_path"),
+		ssoValidateRoleAttributeStrict(),
 	},
 	"saml": {
 		ssoValidateInterdependencyXOR("certificate", "private_key"),
@@ -1061,8 +1147,10 @@ var validationsByProvider = map[string][]validateFunc{
 		ssoValidateInterdependencyXOR("client_id", "client_secret", "token_url"),
 		ssoValidateURL("token_url"),
 	},
-	// no client side validations for LDAP because the settings are nested
-	"ldap": {},
+	// the required fields of LDAP are enforced by the schema, since the settings are nested
+	"ldap": {
+		ssoValidateLdapIgnoredGroupMappings(),
+	},
 }
 
 func validateSSOSettings(provider string, settings map[string]any) error {
@@ -1284,3 +1372,238 @@ func ssoValidateInterdependencyXOR(keys ...string) validateFunc {
 		return nil
 	}
 }
+
+const (
+	// ssoSecretHashPrefix prefixes the hashes of the secrets, which are stored in the state instead of the secrets.
+	ssoSecretHashPrefix = "sha256:"
+	// ssoRedactedSecret is the value of the secrets returned by the SSO settings API. When it's sent back, Grafana keeps the stored secret.
+	ssoRedactedSecret = "*********"
+)
+
+var (
+	ssoSecretFields     = []string{"client_secret", "certificate", "private_key"}
+	ssoLdapSecretFields = []string{"client_key", "client_key_value", "bind_password"}
+)
+
+// hashSSOSecret is the StateFunc of the secrets. Only their hash is stored in the state.
+func hashSSOSecret(v interface{}) string {
+	secret, _ := v.(string)
+	if secret == "" || strings.HasPrefix(secret, ssoSecretHashPrefix) {
+		return secret
+	}
+	sum := sha256.Sum256([]byte(secret))
+	return ssoSecretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// hashSSOSettings hashes the settings sets with their secrets hashed, so that the settings from the config
+// and the settings from the state, which only hold the hashes of the secrets, are the same set item.
+func hashSSOSettings(settingsSchema *schema.Resource) schema.SchemaSetFunc {
+	hash := schema.HashResource(settingsSchema)
+	return func(v interface{}) int {
+		return hash(hashSSOSecretsOf(v))
+	}
+}
+
+// hashSSOSecretsOf returns a copy of the settings with the secrets hashed, including the secrets of the LDAP servers.
+func hashSSOSecretsOf(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		hashed := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if slices.Contains(ssoSecretFields, key) || slices.Contains(ssoLdapSecretFields, key) {
+				hashed[key] = hashSSOSecret(value)
+			} else {
+				hashed[key] = hashSSOSecretsOf(value)
+			}
+		}
+		return hashed
+	case []interface{}:
+		hashed := make([]interface{}, len(v))
+		for i, value := range v {
+			hashed[i] = hashSSOSecretsOf(value)
+		}
+		return hashed
+	}
+	return v
+}
+
+// ssoSettingsStateUpgraders upgrades the states of the version 0 of the schema, which stored the secrets in plain text.
+// The schema is otherwise unchanged.
+func ssoSettingsStateUpgraders(resource *schema.Resource) []schema.StateUpgrader {
+	return []schema.StateUpgrader{{
+		Version: 0,
+		Type:    resource.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			for _, settingsKey := range []string{oauth2SettingsKey, samlSettingsKey, ldapSettingsKey} {
+				if settings, ok := rawState[settingsKey]; ok && settings != nil {
+					rawState[settingsKey] = hashSSOSecretsOf(settings)
+				}
+			}
+			return rawState, nil
+		},
+	}}
+}
+
+// setSSOSecretsForAPI sets the secrets of the settings sent to the API. The state only holds their hashes, so they are read from the config.
+// The secrets which didn't change are sent redacted, so that Grafana keeps the stored ones.
+func setSSOSecretsForAPI(d *schema.ResourceData, provider, settingsKey string, settings map[string]any) error {
+	configSettings := cty.NullVal(cty.DynamicPseudoType)
+	if rawSettings := ssoConfigAttr(d.GetRawConfig(), settingsKey); !rawSettings.IsNull() && rawSettings.IsKnown() {
+		for it := rawSettings.ElementIterator(); it.Next(); {
+			if _, item := it.Element(); !item.IsNull() {
+				configSettings = item
+				break
+			}
+		}
+	}
+	oldSettingsSet, _ := d.GetChange(settingsKey)
+	oldSettings, _ := getSettingsFromList(oldSettingsSet.(*schema.Set).List(), provider)
+
+	setSecrets := func(settings map[string]any, config cty.Value, old map[string]any, fields []string) {
+		for _, field := range fields {
+			secret := ssoConfigString(config, field)
+			oldHash, _ := old[field].(string)
+			if secret != "" && oldHash == hashSSOSecret(secret) {
+				settings[field] = ssoRedactedSecret
+			} else {
+				settings[field] = secret
+			}
+		}
+	}
+
+	if !isLdapProvider(provider) {
+		settingsSchema, err := getSettingsSchema(provider)
+		if err != nil {
+			return err
+		}
+		// Each provider only has some of the secrets, the others mustn't be sent
+		var fields []string
+		for _, field := range ssoSecretFields {
+			if _, ok := settingsSchema.Schema[field]; ok {
+				fields = append(fields, field)
+			}
+		}
+		setSecrets(settings, configSettings, oldSettings, fields)
+		return nil
+	}
+
+	servers := ldapServersOf(settings)
+	oldServers := ldapServersOf(oldSettings)
+	configServers := cty.NullVal(cty.DynamicPseudoType)
+	if configs := ssoConfigAttr(configSettings, "config"); !configs.IsNull() && configs.IsKnown() && configs.LengthInt() > 0 {
+		configServers = ssoConfigAttr(configs.Index(cty.NumberIntVal(0)), "servers")
+	}
+	for i, server := range servers {
+		configServer := cty.NullVal(cty.DynamicPseudoType)
+		if !configServers.IsNull() && configServers.IsKnown() && configServers.LengthInt() > i {
+			configServer = configServers.Index(cty.NumberIntVal(int64(i)))
+		}
+		var oldServer map[string]any
+		if i < len(oldServers) {
+			oldServer = oldServers[i]
+		}
+		setSecrets(server, configServer, oldServer, ssoLdapSecretFields)
+	}
+	return nil
+}
+
+// ldapServersOf returns the servers of the LDAP settings, as read from terraform.
+func ldapServersOf(settings map[string]any) []map[string]any {
+	config, _ := settings["config"].([]any)
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+	var servers []map[string]any
+	items, _ := config[0].(map[string]any)["servers"].([]any)
+	for _, item := range items {
+		if server, ok := item.(map[string]any); ok {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// ssoConfigAttr returns an attribute of a block of the raw config, or null if it's not set.
+func ssoConfigAttr(block cty.Value, name string) cty.Value {
+	if block.IsNull() || !block.IsKnown() || !block.Type().IsObjectType() || !block.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return block.GetAttr(name)
+}
+
+// ssoConfigString returns a string attribute of a block of the raw config, or an empty string if it's not set.
+func ssoConfigString(block cty.Value, name string) string {
+	v := ssoConfigAttr(block, name)
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+	return v.AsString()
+}
+
+func validateJMESPath(v interface{}, k string) ([]string, []error) {
+	expression := v.(string)
+	if expression == "" {
+		return nil, nil
+	}
+	if _, err := jmespath.Compile(expression); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid JMESPath expression: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// validateSSOSettingsCustomizeDiff runs the validations of the provider at plan time, once the settings are known.
+func validateSSOSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	provider := d.Get(providerKey).(string)
+	settingsKey, err := getSettingsKey(provider)
+	if err != nil {
+		return err
+	}
+	if !ssoConfigAttr(d.GetRawConfig(), settingsKey).IsWhollyKnown() {
+		return nil
+	}
+
+	settings, err := getSettingsFromList(d.Get(settingsKey).(*schema.Set).List(), provider)
+	if err != nil {
+		return err
+	}
+	if isOAuth2Provider(provider) {
+		if diags := validateOAuth2CustomFields(settings); diags.HasError() {
+			return fmt.Errorf("%s", diags[0].Summary)
+		}
+		settings = mergeCustomFields(settings)
+	}
+
+	return validateSSOSettings(provider, settings)
+}
+
+// ssoValidateRoleAttributeStrict checks that the role can be extracted when the login is denied without role.
+func ssoValidateRoleAttributeStrict() validateFunc {
+	return func(settingsMap map[string]any, provider string) error {
+		strict, _ := settingsMap["role_attribute_strict"].(bool)
+		skipOrgRoleSync, _ := settingsMap["skip_org_role_sync"].(bool)
+		if strict && !skipOrgRoleSync && settingsMap["role_attribute_path"] == "" {
+			return fmt.Errorf("role_attribute_path must be set when role_attribute_strict is enabled for the provider %s, or all logins are denied", provider)
+		}
+		return nil
+	}
+}
+
+// ssoValidateLdapIgnoredGroupMappings checks that the group mappings of the LDAP servers which ignore them are not set.
+func ssoValidateLdapIgnoredGroupMappings() validateFunc {
+	return func(settingsMap map[string]any, provider string) error {
+		// the config is a list in terraform and an object in the API
+		config, _ := settingsMap["config"].(map[string]any)
+		if configList, ok := settingsMap["config"].([]any); ok && len(configList) > 0 {
+			config, _ = configList[0].(map[string]any)
+		}
+		servers, _ := config["servers"].([]any)
+		for _, item := range servers {
+			server, _ := item.(map[string]any)
+			ignore, _ := server["ignore_group_mappings"].(bool)
+			if mappings, _ := server["group_mappings"].([]any); ignore && len(mappings) > 0 {
+				return fmt.Errorf("group_mappings must not be set for the LDAP server %v when ignore_group_mappings is enabled", server["host"])
+			}
+		}
+		return nil
+	}
+}
@@ -1,6 +1,8 @@
 package grafana_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -40,7 +42,7 @@ func TestSSOSettings_basic_oauth2(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "provider_name", provider),
 						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_id", fmt.Sprintf("new_%s_client_id", provider)),
-						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", fmt.Sprintf("new_%s_client_secret", provider)),
+						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", ssoSecretHash(fmt.Sprintf("new_%s_client_secret", provider))),
 					),
 				},
 				{
@@ -49,7 +51,7 @@ func TestSSOSettings_basic_oauth2(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "provider_name", provider),
 						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_id", fmt.Sprintf("updated_%s_client_id", provider)),
-						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", fmt.Sprintf("updated_%s_client_secret", provider)),
+						resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", ssoSecretHash(fmt.Sprintf("updated_%s_client_secret", provider))),
 					),
 				},
 				{
@@ -120,7 +122,7 @@ func TestSSOSettings_basic_saml(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.metadata_valid_duration", "24h"),
 					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.assertion_attribute_email", "email"),
 					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.client_id", "client_id"),
-					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.client_secret", "client_secret"),
+					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.client_secret", ssoSecretHash("client_secret")),
 					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.token_url", "https://myidp.com/oauth/token"),
 					resource.TestCheckResourceAttr(resourceName, "saml_settings.0.force_use_graph_api", "true"),
 				),
@@ -174,7 +176,7 @@ func TestSSOSettings_basic_ldap(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.host", "127.0.0.5"),
-					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.bind_password", "password"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.bind_password", ssoSecretHash("password")),
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.search_filter", "(cn=%s)"),
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.search_base_dns.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.search_base_dns.0", "dc=grafana,dc=org"),
@@ -195,6 +197,14 @@ func TestSSOSettings_basic_ldap(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"ldap_settings.0.config.0.servers.0.bind_password"},
 			},
+			// Removing all the group mappings removes them from Grafana
+			{
+				Config: testConfigForLdapProviderWithoutGroupMappings,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ldap_settings.0.config.0.servers.0.group_mappings.#", "0"),
+					checkLDAPGroupMappingCount(api, 0),
+				),
+			},
 		},
 	})
 }
@@ -223,7 +233,7 @@ func TestSSOSettings_customFields(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "provider_name", provider),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_id", "client_id"),
-					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", "client_secret"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", ssoSecretHash("client_secret")),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.custom.custom_field", "custom1"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.custom.another_custom_field", "custom2"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.custom.camelCaseField", "custom3"),
@@ -261,7 +271,7 @@ func TestSSOSettings_customFields(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "provider_name", provider),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_id", "client_id_updated"),
-					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", "client_secret"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.client_secret", ssoSecretHash("client_secret")),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.scopes", "email profile"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.custom.custom_field", "custom1_updated"),
 					resource.TestCheckResourceAttr(resourceName, "oauth2_settings.0.custom.another_custom_field", "custom2_updated"),
@@ -525,6 +535,26 @@ const testConfigForLdapProviderUpdated = `resource "grafana_sso_settings" "ldap_
   }
 }`
 
+const testConfigForLdapProviderWithoutGroupMappings = `resource "grafana_sso_settings" "ldap_sso_settings" {
+  provider_name = "ldap"
+  ldap_settings {
+    config {
+      servers {
+        host = "127.0.0.5"
+        search_filter = "(cn=%s)"
+        bind_password = "password"
+        search_base_dns = [
+          "dc=grafana,dc=org",
+        ]
+        attributes = {
+          email = "email"
+          name = "name"
+        }
+      }
+    }
+  }
+}`
+
 const testConfigWithCustomFields = `resource "grafana_sso_settings" "sso_settings" {
   provider_name = "github"
   oauth2_settings {
@@ -616,6 +646,25 @@ var testConfigsWithNoSettings = []string{
 }
 
 var testConfigsWithValidationErrors = []string{
+	// invalid JMESPath expression for the role of github
+	`resource "grafana_sso_settings" "github_sso_settings" {
+	  provider_name = "github"
+	  oauth2_settings {
+	    client_id           = "client_id"
+	    role_attribute_path = "contains(groups[*], 'admin') && 'Admin' ||"
+	  }
+	}`,
+	// role_attribute_strict without role_attribute_path for generic_oauth
+	`resource "grafana_sso_settings" "generic_sso_settings" {
+	  provider_name = "generic_oauth"
+	  oauth2_settings {
+	    client_id             = "client_id"
+	    auth_url              = "https://myidp.com/oauth/authorize"
+	    token_url             = "https://myidp.com/oauth/token"
+	    api_url               = "https://myidp.com/oauth/userinfo"
+	    role_attribute_strict = true
+	  }
+	}`,
 	// no token_url provided for azuread
 	`resource "grafana_sso_settings" "azure_sso_settings" {
 	  provider_name = "azuread"
@@ -739,3 +788,9 @@ var testConfigsWithValidationErrors = []string{
 		}
   }`,
 }
+
+// ssoSecretHash returns the hash of a secret, which is stored in the state instead of the secret.
+func ssoSecretHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
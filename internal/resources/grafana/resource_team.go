@@ -44,6 +44,7 @@ func resourceTeam() *common.Resource {
 		ReadContext:   ReadTeam,
 		UpdateContext: UpdateTeam,
 		DeleteContext: DeleteTeam,
+		CustomizeDiff: diffTeamMembers,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -73,12 +74,16 @@ func resourceTeam() *common.Resource {
 			"members": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 				Description: `
 A set of email addresses corresponding to users who should be given membership
 to the team. Note: users specified here must already exist in Grafana.
+If not set, the members of the team are not managed by this resource, they can
+then be managed with ` + "`grafana_team_member`" + ` resources or outside of Terraform.
+Set it to an empty list to remove all the members.
 `,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					if (new == "[]" && old == "") || (new == "" && old == "[]") {
@@ -364,6 +369,10 @@ func readTeamMembers(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData) diag.
 }
 
 func UpdateMembers(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData) error {
+	if d.GetRawConfig().GetAttr("members").IsNull() {
+		// The members aren't managed by this resource, the current ones are kept
+		return nil
+	}
 	stateMembers, configMembers, err := collectMembers(d)
 	if err != nil {
 		return err
@@ -379,6 +388,20 @@ func UpdateMembers(client *goapi.GrafanaHTTPAPI, d *schema.ResourceData) error {
 	return applyMemberChanges(client, int64(d.Get("team_id").(int)), changes)
 }
 
+// diffTeamMembers plans the removal of all the members when `members` is set to an empty list.
+// Empty lists can't be told apart from unset attributes in the plan of computed attributes.
+func diffTeamMembers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	members := config.GetAttr("members")
+	if !members.IsKnown() || members.IsNull() || members.LengthInt() > 0 || d.Get("members").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	return d.SetNew("members", []string{})
+}
+
 func collectMembers(d *schema.ResourceData) (map[string]TeamMember, map[string]TeamMember, error) {
 	stateMembers, configMembers := make(map[string]TeamMember), make(map[string]TeamMember)
 
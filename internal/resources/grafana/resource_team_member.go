@@ -0,0 +1,178 @@
+package grafana
+
+import (
+	"context"
+	"strconv"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/teams"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Permissions of the members of a team. Admins can manage the team and its members.
+const (
+	teamMemberPermissionMember models.PermissionType = 0
+	teamMemberPermissionAdmin  models.PermissionType = 4
+)
+
+var resourceTeamMemberID = common.NewResourceID(
+	common.OptionalIntIDField("orgID"),
+	common.IntIDField("teamID"),
+	common.IntIDField("userID"),
+)
+
+func resourceTeamMember() *common.Resource {
+	schema := &schema.Resource{
+		Description: `
+Manages the membership of a single user in a team. The other members of the team are kept.
+
+**Note:** When this resource is used, the ` + "`members`" + ` attribute of the ` + "`grafana_team`" + ` must not be set, or both resources will conflict.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/team-management/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/team/#add-team-member)
+`,
+
+		CreateContext: createTeamMember,
+		ReadContext:   readTeamMember,
+		UpdateContext: updateTeamMember,
+		DeleteContext: deleteTeamMember,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the team.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					_, old = SplitOrgResourceID(old)
+					_, new = SplitOrgResourceID(new)
+					return old == new
+				},
+			},
+			"user_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The numerical ID of the user.",
+			},
+			"admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user is an admin of the team. Team admins can manage the team and its members.",
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_team_member",
+		resourceTeamMemberID,
+		schema,
+	)
+}
+
+func createTeamMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, teamIDStr := OAPIClientFromExistingOrgResource(meta, d.Get("team_id").(string))
+	teamID, err := strconv.ParseInt(teamIDStr, 10, 64)
+	if err != nil {
+		return diag.Errorf("invalid team ID %s: %s", teamIDStr, err)
+	}
+	userID := int64(d.Get("user_id").(int))
+
+	if _, err := client.Teams.AddTeamMember(teamIDStr, &models.AddTeamMemberCommand{UserID: userID}); err != nil {
+		return diag.Errorf("failed to add user %d to team %d: %s", userID, teamID, err)
+	}
+	if d.Get("admin").(bool) {
+		if err := updateTeamMemberPermission(client, teamID, userID, teamMemberPermissionAdmin); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(resourceTeamMemberID.Make(orgID, teamID, userID))
+	return readTeamMember(ctx, d, meta)
+}
+
+func readTeamMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, orgID, teamID, userID, err := splitTeamMemberID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Teams.GetTeamMembers(strconv.FormatInt(teamID, 10))
+	if err, shouldReturn := common.CheckReadError("team", d, err); shouldReturn {
+		return err
+	}
+	for _, member := range resp.Payload {
+		if member.UserID == userID {
+			d.Set("team_id", MakeOrgResourceID(orgID, teamID))
+			d.Set("user_id", userID)
+			d.Set("admin", member.Permission == teamMemberPermissionAdmin)
+			return nil
+		}
+	}
+
+	return common.WarnMissing("team member", d)
+}
+
+func updateTeamMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, teamID, userID, err := splitTeamMemberID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission := teamMemberPermissionMember
+	if d.Get("admin").(bool) {
+		permission = teamMemberPermissionAdmin
+	}
+	if err := updateTeamMemberPermission(client, teamID, userID, permission); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readTeamMember(ctx, d, meta)
+}
+
+func deleteTeamMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, teamID, userID, err := splitTeamMemberID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Teams.RemoveTeamMember(userID, strconv.FormatInt(teamID, 10))
+	diag, _ := common.CheckReadError("team member", d, err)
+	return diag
+}
+
+// splitTeamMemberID splits the ID of a team member, and returns a client for the org of the team.
+func splitTeamMemberID(meta interface{}, id string) (client *goapi.GrafanaHTTPAPI, orgID, teamID, userID int64, err error) {
+	split, err := resourceTeamMemberID.Split(id)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	if len(split) == 3 {
+		orgID = split[0].(int64)
+		split = split[1:]
+	}
+	client = meta.(*common.Client).GrafanaAPI.Clone()
+	if orgID == 0 {
+		orgID = client.OrgID()
+	} else {
+		client = client.WithOrgID(orgID)
+	}
+	return client, orgID, split[0].(int64), split[1].(int64), nil
+}
+
+func updateTeamMemberPermission(client *goapi.GrafanaHTTPAPI, teamID, userID int64, permission models.PermissionType) error {
+	params := teams.NewUpdateTeamMemberParams().
+		WithTeamID(strconv.FormatInt(teamID, 10)).
+		WithUserID(userID).
+		WithBody(&models.UpdateTeamMemberCommand{Permission: permission})
+	_, err := client.Teams.UpdateTeamMember(params)
+	return err
+}
@@ -0,0 +1,97 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v3/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTeamMember_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var team models.TeamDTO
+	var user models.UserProfileDTO
+	name := acctest.RandString(10)
+
+	config := testutils.TestAccExampleWithReplace(t, "resources/grafana_team_member/resource.tf", map[string]string{
+		`"Platform"`:        `"` + name + `"`,
+		"alice@example.com": name + "@example.com",
+		`"alice"`:           `"` + name + `"`,
+	})
+	// The team is updated without setting its members
+	renamedConfig := strings.Replace(config, `name = "`+name+`"`, `name = "`+name+`-renamed"`, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             teamCheckExists.destroyed(&team, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					teamCheckExists.exists("grafana_team.platform", &team),
+					userCheckExists.exists("grafana_user.alice", &user),
+					resource.TestMatchResourceAttr("grafana_team_member.alice", "id", defaultOrgIDRegexp),
+					resource.TestCheckResourceAttrPair("grafana_team_member.alice", "team_id", "grafana_team.platform", "id"),
+					resource.TestCheckResourceAttrPair("grafana_team_member.alice", "user_id", "grafana_user.alice", "user_id"),
+					resource.TestCheckResourceAttr("grafana_team_member.alice", "admin", "true"),
+					testAccTeamMemberCheckPermission(&team, &user, "admin"),
+				),
+			},
+			{
+				ResourceName:      "grafana_team_member.alice",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: strings.Replace(config, "admin   = true", "admin   = false", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_team_member.alice", "admin", "false"),
+					testAccTeamMemberCheckPermission(&team, &user, "member"),
+				),
+			},
+			// Updating the team keeps the members managed outside of it
+			{
+				Config: renamedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_team.platform", "name", name+"-renamed"),
+					resource.TestCheckTypeSetElemAttr("grafana_team.platform", "members.*", name+"@example.com"),
+					testAccTeamMemberCheckPermission(&team, &user, "admin"),
+				),
+			},
+			{
+				Config: testutils.WithoutResource(t, renamedConfig, "grafana_team_member.alice"),
+				Check:  testAccTeamMemberCheckPermission(&team, &user, ""),
+			},
+		},
+	})
+}
+
+// testAccTeamMemberCheckPermission checks the permission of the user in the team (`admin` or `member`). An empty permission means the user isn't a member.
+func testAccTeamMemberCheckPermission(team *models.TeamDTO, user *models.UserProfileDTO, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := grafanaTestClient().WithOrgID(team.OrgID).Teams.GetTeamMembers(strconv.FormatInt(team.ID, 10))
+		if err != nil {
+			return err
+		}
+		permission := ""
+		for _, member := range resp.Payload {
+			if member.UserID == user.ID {
+				permission = "member"
+				if member.Permission == 4 {
+					permission = "admin"
+				}
+			}
+		}
+		if permission != expected {
+			return fmt.Errorf("expected user %d to be %q of team %d, got %q", user.ID, expected, team.ID, permission)
+		}
+		return nil
+	}
+}
@@ -85,18 +85,34 @@ func addValidationToResources(resources ...*common.Resource) []*common.Resource
 }
 
 var DataSources = addValidationToDataSources(
+	datasourceAlertRuleEvaluation(),
+	datasourceContactPoint(),
+	datasourceContactPoints(),
+	datasourceMuteTiming(),
+	datasourceNotificationPolicy(),
+	datasourceRuleGroup(),
+	datasourceSilences(),
 	datasourceDashboard(),
 	datasourceDashboards(),
+	datasourceDashboardVersions(),
 	datasourceDatasource(),
+	datasourceDatasourceHealth(),
+	datasourceFeatureToggles(),
 	datasourceFolder(),
 	datasourceFolders(),
+	datasourceFolderTree(),
+	datasourceLDAPUserLookup(),
 	datasourceLibraryPanel(),
 	datasourceLibraryPanels(),
 	datasourceUser(),
+	datasourceUserEffectivePermissions(),
+	datasourceUserOrganizations(),
 	datasourceUsers(),
 	datasourceRole(),
 	datasourceServiceAccount(),
+	datasourceServiceAccounts(),
 	datasourceTeam(),
+	datasourceTeamEffectivePermissions(),
 	datasourceOrganization(),
 	datasourceOrganizationPreferences(),
 )
@@ -112,9 +128,13 @@ var Resources = addValidationToResources(
 	resourceContactPoint(),
 	resourceDashboard(),
 	resourcePublicDashboard(),
+	resourceDashboardSnapshot(),
+	resourceDashboards(),
+	resourceDashboardVersionRestore(),
 	resourceDashboardPermission(),
 	resourceDataSource(),
 	resourceDataSourceConfig(),
+	resourceDataSourceCorrelation(),
 	resourceDatasourcePermission(),
 	resourceFolder(),
 	resourceFolderPermission(),
@@ -123,17 +143,24 @@ var Resources = addValidationToResources(
 	resourceMuteTiming(),
 	resourceNotificationPolicy(),
 	resourceOrganization(),
+	resourceOrgInvite(),
+	resourceOrgUser(),
 	resourceOrganizationPreferences(),
 	resourcePlaylist(),
 	resourceReport(),
 	resourceRole(),
+	resourceRolePermissionItem(),
 	resourceRoleAssignment(),
 	resourceRuleGroup(),
+	resourceSilence(),
 	resourceTeam(),
 	resourceTeamExternalGroup(),
+	resourceTeamMember(),
 	resourceServiceAccountToken(),
 	resourceServiceAccount(),
 	resourceServiceAccountPermission(),
+	resourceServerSetting(),
+	resourceLDAPGroupMapping(),
 	resourceSSOSettings(),
 	resourceUser(),
 )
@@ -13,6 +13,7 @@ import (
 //
 //go:generate go run ./genreferences --file=$GOFILE --walk-dir=../../..
 var knownReferences = []string{
+	"grafana_alerting_silence.org_id=grafana_organization.id",
 	"grafana_annotation.dashboard_uid=grafana_dashboard.uid",
 	"grafana_annotation.org_id=grafana_organization.id",
 	"grafana_cloud_access_policy.identifier=grafana_cloud_stack.id",
@@ -40,11 +41,17 @@ var knownReferences = []string{
 	"grafana_dashboard_permission_item.user=grafana_user.id",
 	"grafana_dashboard_public.dashboard_uid=grafana_dashboard.uid",
 	"grafana_dashboard_public.org_id=grafana_organization.org_id",
+	"grafana_dashboard_snapshot.dashboard_uid=grafana_dashboard.uid",
+	"grafana_dashboard_version_restore.dashboard_uid=grafana_dashboard.uid",
+	"grafana_dashboards.folder=grafana_folder.uid",
 	"grafana_data_source.datasourceUid=grafana_data_source.uid",
+	"grafana_data_source.datasource_uid=grafana_data_source.uid",
 	"grafana_data_source.org_id=grafana_organization.id",
 	"grafana_data_source_config.datasourceUid=grafana_data_source.uid",
 	"grafana_data_source_config.uid=grafana_data_source.uid",
 	"grafana_data_source_config_lbac_rules.datasource_uid=grafana_data_source.uid",
+	"grafana_data_source_correlation.source_uid=grafana_data_source.uid",
+	"grafana_data_source_correlation.target_uid=grafana_data_source.uid",
 	"grafana_data_source_permission.datasource_uid=grafana_data_source.uid",
 	"grafana_data_source_permission.team_id=grafana_team.id",
 	"grafana_data_source_permission.user_id=grafana_service_account.id",
@@ -64,6 +71,7 @@ var knownReferences = []string{
 	"grafana_folder_permission_item.team=grafana_team.id",
 	"grafana_folder_permission_item.user=grafana_service_account.id",
 	"grafana_folder_permission_item.user=grafana_user.id",
+	"grafana_ldap_group_mapping.server_host=grafana_sso_settings.ldap_settings",
 	"grafana_library_panel.folder_uid=grafana_folder.uid",
 	"grafana_library_panel.org_id=grafana_organization.id",
 	"grafana_machine_learning_alert.job_id=grafana_machine_learning_job.id",
@@ -78,6 +86,8 @@ var knownReferences = []string{
 	"grafana_oncall_integration.escalation_chain_id=grafana_oncall_escalation_chain.id",
 	"grafana_oncall_route.escalation_chain_id=grafana_oncall_escalation_chain.id",
 	"grafana_oncall_route.integration_id=grafana_oncall_integration.id",
+	"grafana_org_user.login_or_email=grafana_user.email",
+	"grafana_org_user.org_id=grafana_organization.org_id",
 	"grafana_organization.org_id=grafana_organization.id",
 	"grafana_organization_preferences.home_dashboard_uid=grafana_dashboard.uid",
 	"grafana_organization_preferences.org_id=grafana_organization.id",
@@ -97,6 +107,7 @@ var knownReferences = []string{
 	"grafana_role_assignment_item.service_account_id=grafana_service_account.id",
 	"grafana_role_assignment_item.team_id=grafana_team.id",
 	"grafana_role_assignment_item.user_id=grafana_user.id",
+	"grafana_role_permission_item.role_uid=grafana_role.uid",
 	"grafana_rule_group.contact_point=grafana_contact_point.name",
 	"grafana_rule_group.folder_uid=grafana_folder.uid",
 	"grafana_rule_group.org_id=grafana_organization.id",
@@ -126,6 +137,8 @@ var knownReferences = []string{
 	"grafana_team.home_dashboard_uid=grafana_dashboard.uid",
 	"grafana_team.org_id=grafana_organization.id",
 	"grafana_team_external_group.team_id=grafana_team.id",
+	"grafana_team_member.team_id=grafana_team.id",
+	"grafana_team_member.user_id=grafana_user.user_id",
 	"grafana_team_preferences.home_dashboard_uid=grafana_dashboard.uid",
 	"grafana_team_preferences.team_id=grafana_team.id",
 }
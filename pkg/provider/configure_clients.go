@@ -73,6 +73,10 @@ func CreateClients(providerConfig ProviderConfig) (*common.Client, error) {
 	}
 
 	grafana.StoreDashboardSHA256 = providerConfig.StoreDashboardSha256.ValueBool()
+	c.DashboardIgnoreJSONPaths = setToStringArray(providerConfig.DashboardIgnoreJSONPaths.Elements())
+	if err := grafana.ValidateDashboardJSONPaths(c.DashboardIgnoreJSONPaths); err != nil {
+		return nil, fmt.Errorf("invalid dashboard_ignore_json_paths: %w", err)
+	}
 
 	return c, nil
 }
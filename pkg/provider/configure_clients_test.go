@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/grafana/terraform-provider-grafana/v3/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -171,6 +172,29 @@ func TestCreateClients(t *testing.T) {
 				assert.Equal(t, "http://localhost:3000", c.OnCallClient.GrafanaURL().String())
 			},
 		},
+		{
+			name: "Dashboard ignore JSON paths",
+			config: ProviderConfig{
+				URL:                      types.StringValue("http://localhost:3000"),
+				Auth:                     types.StringValue("admin:admin"),
+				DashboardIgnoreJSONPaths: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("$.time")}),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, []string{"$.time"}, c.DashboardIgnoreJSONPaths)
+			},
+		},
+		{
+			name: "Invalid dashboard ignore JSON paths",
+			config: ProviderConfig{
+				URL:                      types.StringValue("http://localhost:3000"),
+				Auth:                     types.StringValue("admin:admin"),
+				DashboardIgnoreJSONPaths: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("time")}),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.EqualError(t, err, "invalid dashboard_ignore_json_paths: invalid JSON path \"time\": must start with `$` (JSONPath) or `/` (JSON pointer)")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
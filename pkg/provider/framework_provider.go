@@ -29,7 +29,8 @@ type ProviderConfig struct {
 	CACert             types.String `tfsdk:"ca_cert"`
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 
-	StoreDashboardSha256 types.Bool `tfsdk:"store_dashboard_sha256"`
+	StoreDashboardSha256     types.Bool `tfsdk:"store_dashboard_sha256"`
+	DashboardIgnoreJSONPaths types.List `tfsdk:"dashboard_ignore_json_paths"`
 
 	CloudAccessPolicyToken types.String `tfsdk:"cloud_access_policy_token"`
 	CloudAPIURL            types.String `tfsdk:"cloud_api_url"`
@@ -171,6 +172,11 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
 			},
+			"dashboard_ignore_json_paths": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Default value of the `ignore_json_paths` attribute of `grafana_dashboard` resources. Paths of the dashboard model JSON to ignore, in JSONPath (ex: `$.time`) or JSON pointer (ex: `/time`) syntax.",
+			},
 
 			"cloud_access_policy_token": schema.StringAttribute{
 				Optional:            true,
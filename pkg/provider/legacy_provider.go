@@ -122,6 +122,12 @@ func Provider(version string) *schema.Provider {
 				Optional:    true,
 				Description: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
 			},
+			"dashboard_ignore_json_paths": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Default value of the `ignore_json_paths` attribute of `grafana_dashboard` resources. Paths of the dashboard model JSON to ignore, in JSONPath (ex: `$.time`) or JSON pointer (ex: `/time`) syntax.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 
 			"oncall_access_token": {
 				Type:        schema.TypeString,
@@ -215,6 +221,15 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			statusCodes = types.SetValueMust(types.StringType, statusCodesValue)
 		}
 
+		dashboardIgnoreJSONPaths := types.ListNull(types.StringType)
+		if v, ok := d.GetOk("dashboard_ignore_json_paths"); ok {
+			pathsValue := []attr.Value{}
+			for _, v := range v.([]interface{}) {
+				pathsValue = append(pathsValue, types.StringValue(v.(string)))
+			}
+			dashboardIgnoreJSONPaths = types.ListValueMust(types.StringType, pathsValue)
+		}
+
 		cfg := ProviderConfig{
 			Auth:                      stringValueOrNull(d, "auth"),
 			URL:                       stringValueOrNull(d, "url"),
@@ -233,6 +248,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			ConnectionsAPIAccessToken: stringValueOrNull(d, "connections_api_access_token"),
 			ConnectionsAPIURL:         stringValueOrNull(d, "connections_api_url"),
 			StoreDashboardSha256:      boolValueOrNull(d, "store_dashboard_sha256"),
+			DashboardIgnoreJSONPaths:  dashboardIgnoreJSONPaths,
 			HTTPHeaders:               headers,
 			Retries:                   int64ValueOrNull(d, "retries"),
 			RetryStatusCodes:          statusCodes,